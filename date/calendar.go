@@ -0,0 +1,236 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// maxNonBusinessDays limits search for business day to avoid infinite loop,
+// e.g. if Calendar.Weekend accepts all dates.
+const maxNonBusinessDays = 3660
+
+// easterCycle is count of years after which dates of Easter repeat in Gregorian calendar.
+const easterCycle = 5700000
+
+// Calendar represents business-day calendar.
+// Business day is date which is neither weekend nor holiday.
+// Zero value treats Saturday and Sunday as weekend and has no holidays.
+//
+// Calendar methods searching business day return ErrNoBusinessDay if no business day is found within 10 years.
+type Calendar struct {
+	// Weekend accepts non-business weekdays.
+	// If nil, Saturday and Sunday are used.
+	Weekend Filter
+
+	// Holidays are holiday providers, e.g. FixedHoliday, EasterHoliday or HolidayList.
+	Holidays []Filter
+}
+
+// IsBusinessDay returns true if passed date is neither weekend nor holiday.
+func (c Calendar) IsBusinessDay(date Date) bool {
+	return !c.IsWeekend(date) && !c.IsHoliday(date)
+}
+
+// IsWeekend returns true if passed date is weekend.
+func (c Calendar) IsWeekend(date Date) bool {
+	if c.Weekend == nil {
+		w := date.Weekday()
+		return w == Saturday || w == Sunday
+	}
+	return c.Weekend.Contains(date)
+}
+
+// IsHoliday returns true if passed date is accepted by any holiday provider.
+func (c Calendar) IsHoliday(date Date) bool {
+	for _, h := range c.Holidays {
+		if h.Contains(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextBusinessDay returns the first business day after passed date.
+func (c Calendar) NextBusinessDay(date Date) (Date, error) {
+	d, err := c.step(date, 1)
+	if err != nil {
+		return Date{}, fmt.Errorf("date.Calendar.NextBusinessDay: %w", err)
+	}
+	return d, nil
+}
+
+// PreviousBusinessDay returns the last business day before passed date.
+func (c Calendar) PreviousBusinessDay(date Date) (Date, error) {
+	d, err := c.step(date, -1)
+	if err != nil {
+		return Date{}, fmt.Errorf("date.Calendar.PreviousBusinessDay: %w", err)
+	}
+	return d, nil
+}
+
+// AddBusinessDays adds passed count of business days to date.
+// Negative count subtracts business days.
+// If count is zero, passed date is returned if it is business day, otherwise the next business day.
+func (c Calendar) AddBusinessDays(date Date, count int) (Date, error) {
+	if count == 0 {
+		if c.IsBusinessDay(date) {
+			return date, nil
+		}
+		count = 1
+	}
+	direction := 1
+	if count < 0 {
+		direction, count = -1, -count
+	}
+	for ; count > 0; count-- {
+		var err error
+		if date, err = c.step(date, direction); err != nil {
+			return Date{}, fmt.Errorf("date.Calendar.AddBusinessDays: %w", err)
+		}
+	}
+	return date, nil
+}
+
+// BusinessDaysBetween returns count of business days after from date up to and including to date.
+// If to date is before from date, result is negative count of business days after to date up to and including from date.
+// For business day to, AddBusinessDays(from, BusinessDaysBetween(from, to)) returns to.
+func (c Calendar) BusinessDaysBetween(from, to Date) int {
+	sign := 1
+	if to.Before(from) {
+		from, to, sign = to, from, -1
+	}
+	count := 0
+	for d := from.Add(0, 0, 1); !d.After(to); d = d.Add(0, 0, 1) {
+		if c.IsBusinessDay(d) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+// step returns the nearest business day in passed direction (1 or -1).
+func (c Calendar) step(date Date, direction int) (Date, error) {
+	for i := 0; i < maxNonBusinessDays; i++ {
+		date = date.Add(0, 0, direction)
+		if c.IsBusinessDay(date) {
+			return date, nil
+		}
+	}
+	return Date{}, fmt.Errorf("%w within %d days", ErrNoBusinessDay, maxNonBusinessDays)
+}
+
+// FixedHoliday creates holiday provider accepting the same month and day every year, e.g. December 25.
+func FixedHoliday(month Month, day int) Filter {
+	return &holidayFixed{month: month, day: day}
+}
+
+// EasterHoliday creates holiday provider accepting date with passed offset from Easter Sunday every year.
+// For example, offset -2 is Good Friday and offset 1 is Easter Monday.
+func EasterHoliday(offset int) Filter {
+	return &holidayEaster{offset: offset}
+}
+
+// Easter returns date of Easter Sunday (Western Christianity) in passed year.
+// It uses anonymous Gregorian algorithm on proleptic Gregorian calendar, so it works for non-positive years too.
+func Easter(year int) Date {
+	// dates of Easter repeat every easterCycle years, so year is shifted into non-negative
+	// cycle where truncating division of algorithm equals floored division
+	y := year % easterCycle
+	if y < 0 {
+		y += easterCycle
+	}
+	a := y % 19
+	b, c := y/100, y%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return New(year, Month(month), day)
+}
+
+// Holiday represents one holiday of HolidayList.
+type Holiday struct {
+	Date Date   `json:"date"`
+	Name string `json:"name,omitempty"`
+}
+
+// HolidayList represents explicit list of holidays and implements Filter.
+//
+// JSON form is array of dates or objects with date and optional name, e.g.:
+//   ["2026-12-24", {"date": "2026-12-25", "name": "Christmas Day"}]
+type HolidayList []Holiday
+
+// Contains returns true if passed date is in holiday list.
+func (l HolidayList) Contains(date Date) bool {
+	for _, h := range l {
+		if h.Date.Equal(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// Describe returns description of holiday list.
+func (l HolidayList) Describe() string {
+	return "holiday list of " + strconv.Itoa(len(l)) + " dates"
+}
+
+// UnmarshalJSON parses holiday list from JSON array of dates and/or objects.
+func (l *HolidayList) UnmarshalJSON(data []byte) error {
+	items := []json.RawMessage(nil)
+	if err := json.Unmarshal(data, &items); err != nil {
+		return fmt.Errorf("date.HolidayList.UnmarshalJSON: %w", err)
+	}
+	list := make(HolidayList, len(items))
+	for i, item := range items {
+		target := any(&list[i])
+		if len(item) != 0 && item[0] == '"' {
+			target = &list[i].Date
+		}
+		if err := json.Unmarshal(item, target); err != nil {
+			return fmt.Errorf("date.HolidayList.UnmarshalJSON: %w", err)
+		}
+	}
+	*l = list
+	return nil
+}
+
+type holidayFixed struct {
+	month Month
+	day   int
+}
+
+func (h *holidayFixed) Contains(date Date) bool {
+	return date.Month() == h.month && date.Day() == h.day
+}
+
+func (h *holidayFixed) Describe() string {
+	return "on " + h.month.String() + " " + strconv.Itoa(h.day) + " every year"
+}
+
+type holidayEaster struct {
+	offset int
+}
+
+func (h *holidayEaster) Contains(date Date) bool {
+	// offset can move holiday into another year, so Easter is looked up in year of date shifted back
+	e := date.Add(0, 0, -h.offset)
+	return Easter(e.Year()).Equal(e)
+}
+
+func (h *holidayEaster) Describe() string {
+	if h.offset == 0 {
+		return "on Easter Sunday"
+	}
+	return "on Easter Sunday " + fmt.Sprintf("%+d", h.offset) + " days"
+}
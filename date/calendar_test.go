@@ -0,0 +1,211 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCalendar() Calendar {
+	return Calendar{
+		Holidays: []Filter{
+			FixedHoliday(January, 1),
+			EasterHoliday(-2),
+			EasterHoliday(1),
+			FixedHoliday(December, 24),
+			FixedHoliday(December, 25),
+			FixedHoliday(December, 26),
+		},
+	}
+}
+
+func Test_Easter(t *testing.T) {
+	assert.Equal(t, New(2000, April, 23), Easter(2000))
+	assert.Equal(t, New(2008, March, 23), Easter(2008))
+	assert.Equal(t, New(2019, April, 21), Easter(2019))
+	assert.Equal(t, New(2024, March, 31), Easter(2024))
+	assert.Equal(t, New(2025, April, 20), Easter(2025))
+	assert.Equal(t, New(2026, April, 5), Easter(2026))
+	assert.Equal(t, New(2038, April, 25), Easter(2038))
+	for _, year := range []int{0, -1, -44, -2026, -easterCycle - 1, 1 - 1<<31} {
+		e := Easter(year)
+		assert.Equal(t, year, e.Year(), year)
+		assert.Equal(t, Sunday, e.Weekday(), year)
+		assert.False(t, e.Before(New(year, March, 22)), year)
+		assert.False(t, e.After(New(year, April, 25)), year)
+		f := Easter(year + easterCycle)
+		assert.Equal(t, f.Month(), e.Month(), year)
+		assert.Equal(t, f.Day(), e.Day(), year)
+	}
+}
+
+func Test_Calendar_IsBusinessDay(t *testing.T) {
+	c := testCalendar()
+	assert.True(t, c.IsBusinessDay(New(2026, April, 2)))
+	assert.False(t, c.IsBusinessDay(New(2026, April, 3)))
+	assert.False(t, c.IsBusinessDay(New(2026, April, 4)))
+	assert.False(t, c.IsBusinessDay(New(2026, April, 5)))
+	assert.False(t, c.IsBusinessDay(New(2026, April, 6)))
+	assert.True(t, c.IsBusinessDay(New(2026, April, 7)))
+	assert.False(t, c.IsBusinessDay(New(2026, December, 24)))
+	assert.True(t, c.IsWeekend(New(2026, October, 18)))
+	assert.False(t, c.IsHoliday(New(2026, October, 18)))
+
+	c.Weekend = FilterWeekdays(Friday, Saturday)
+	assert.False(t, c.IsBusinessDay(New(2026, October, 16)))
+	assert.True(t, c.IsBusinessDay(New(2026, October, 18)))
+}
+
+func Test_Calendar_NextBusinessDay(t *testing.T) {
+	c := testCalendar()
+	for _, v := range []struct {
+		date     Date
+		next     bool
+		expected Date
+	}{
+		{New(2026, April, 2), true, New(2026, April, 7)},
+		{New(2026, April, 7), false, New(2026, April, 2)},
+		{New(2026, October, 16), true, New(2026, October, 19)},
+		{New(2026, October, 19), false, New(2026, October, 16)},
+	} {
+		f := c.PreviousBusinessDay
+		if v.next {
+			f = c.NextBusinessDay
+		}
+		d, err := f(v.date)
+		require.NoError(t, err, v.date)
+		assert.Equal(t, v.expected, d, v.date)
+	}
+}
+
+func Test_Calendar_AddBusinessDays(t *testing.T) {
+	c := testCalendar()
+	from := New(2026, December, 22)
+	for _, v := range []struct {
+		date     Date
+		count    int
+		expected Date
+	}{
+		{from, 0, from},
+		{from, 1, New(2026, December, 23)},
+		{from, 2, New(2026, December, 28)},
+		{from, 5, New(2026, December, 31)},
+		{from, 6, New(2027, January, 4)},
+		{from, -1, New(2026, December, 21)},
+		{from, -2, New(2026, December, 18)},
+		{New(2026, December, 25), 0, New(2026, December, 28)},
+	} {
+		d, err := c.AddBusinessDays(v.date, v.count)
+		require.NoError(t, err, v.count)
+		assert.Equal(t, v.expected, d, v.count)
+	}
+}
+
+func Test_Calendar_noBusinessDay(t *testing.T) {
+	date := New(2026, October, 18)
+	holidays := make(HolidayList, 0, maxNonBusinessDays)
+	for d := date.Add(0, 0, 1); len(holidays) < maxNonBusinessDays; d = d.Add(0, 0, 1) {
+		if w := d.Weekday(); w != Saturday && w != Sunday {
+			holidays = append(holidays, Holiday{Date: d})
+		}
+	}
+	for _, c := range []Calendar{
+		{Weekend: Any()},
+		{Holidays: []Filter{holidays}},
+	} {
+		_, err := c.NextBusinessDay(date)
+		assert.ErrorIs(t, err, ErrNoBusinessDay)
+		_, err = c.AddBusinessDays(date, 1)
+		assert.ErrorIs(t, err, ErrNoBusinessDay)
+		_, err = c.AddBusinessDays(date.Add(0, 0, 1), 0)
+		assert.ErrorIs(t, err, ErrNoBusinessDay)
+	}
+	_, err := Calendar{Weekend: Any()}.PreviousBusinessDay(date)
+	assert.EqualError(t, err, "date.Calendar.PreviousBusinessDay: no business day within 3660 days")
+}
+
+func Test_Calendar_BusinessDaysBetween(t *testing.T) {
+	c := testCalendar()
+	from := New(2026, December, 22)
+	assert.Equal(t, 0, c.BusinessDaysBetween(from, from))
+	assert.Equal(t, 1, c.BusinessDaysBetween(from, New(2026, December, 23)))
+	assert.Equal(t, 1, c.BusinessDaysBetween(from, New(2026, December, 27)))
+	assert.Equal(t, 6, c.BusinessDaysBetween(from, New(2027, January, 4)))
+	assert.Equal(t, -2, c.BusinessDaysBetween(from, New(2026, December, 18)))
+	for n := -10; n <= 10; n++ {
+		to, err := c.AddBusinessDays(from, n)
+		require.NoError(t, err, n)
+		assert.Equal(t, n, c.BusinessDaysBetween(from, to), n)
+	}
+}
+
+func Test_HolidayList(t *testing.T) {
+	l := HolidayList(nil)
+	require.NoError(t, json.Unmarshal([]byte(`["2026-12-24", {"date": "2026-12-31", "name": "New Year's Eve"}]`), &l))
+	assert.Equal(t, HolidayList{
+		{Date: New(2026, December, 24)},
+		{Date: New(2026, December, 31), Name: "New Year's Eve"},
+	}, l)
+	assert.True(t, l.Contains(New(2026, December, 31)))
+	assert.False(t, l.Contains(New(2026, December, 30)))
+	assert.Equal(t, "holiday list of 2 dates", Describe(l))
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), &l))
+	assert.Error(t, json.Unmarshal([]byte(`["2026-13-01"]`), &l))
+	assert.Len(t, l, 2)
+}
+
+func Test_EasterHoliday(t *testing.T) {
+	h := EasterHoliday(-2)
+	assert.True(t, h.Contains(New(2026, April, 3)))
+	assert.False(t, h.Contains(New(2026, April, 5)))
+
+	// Easter 2027 is on March 28, 90 days before is in 2026
+	h = EasterHoliday(-90)
+	assert.True(t, h.Contains(New(2026, December, 28)))
+	assert.False(t, h.Contains(New(2027, December, 28)))
+	assert.True(t, Calendar{Holidays: []Filter{h}}.IsHoliday(New(2026, December, 28)))
+	h = EasterHoliday(300)
+	assert.True(t, h.Contains(Easter(2026).Add(0, 0, 300)))
+	assert.Equal(t, 2027, Easter(2026).Add(0, 0, 300).Year())
+}
+
+func Test_holiday_Describe(t *testing.T) {
+	assert.Equal(t, "on December 25 every year", Describe(FixedHoliday(December, 25)))
+	assert.Equal(t, "on Easter Sunday", Describe(EasterHoliday(0)))
+	assert.Equal(t, "on Easter Sunday -2 days", Describe(EasterHoliday(-2)))
+	assert.Equal(t, "on Easter Sunday +1 days", Describe(EasterHoliday(1)))
+}
+
+func ExampleCalendar() {
+	c := Calendar{
+		Holidays: []Filter{
+			FixedHoliday(December, 25),
+			FixedHoliday(December, 26),
+			EasterHoliday(1), // Easter Monday
+		},
+	}
+	d, err := c.AddBusinessDays(New(2026, December, 24), 1)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(d)
+	d, err = c.NextBusinessDay(New(2026, April, 3))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(d)
+	fmt.Println(c.BusinessDaysBetween(New(2026, December, 21), New(2026, December, 31)))
+
+	// Output:
+	// 2026-12-28
+	// 2026-04-07
+	// 7
+}
@@ -0,0 +1,302 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"go.lstv.dev/util/constraint"
+	"go.lstv.dev/util/internal"
+)
+
+// clockMaxInputLength is length of the longest clock text form, i.e. hh:mm:ss.nnnnnnnnn.
+const clockMaxInputLength = 18
+
+// nsPerDay is duration of one day in nanoseconds.
+const nsPerDay = int64(24 * time.Hour)
+
+var (
+	// ClockFormatter is used by Clock.MarshalText and other Clock converting functions.
+	ClockFormatter = DefaultClockFormatter
+
+	// ClockParser is used by Clock.UnmarshalText function.
+	ClockParser = DefaultClockParser[[]byte]
+
+	patternClock = regexp.MustCompile(`^(2[0-3]|[01][0-9])(:?)([0-5][0-9])(?:(:?)([0-5][0-9])(?:[.,]([0-9]{1,9}))?)?$`)
+)
+
+// Clock is representation of time of day (wall clock) with nanosecond precision.
+// Zero value is valid, representing midnight 00:00.
+type Clock struct {
+	ns int64
+}
+
+// NewClock creates clock with specific hour, minute, second and nanosecond.
+// Values out of range are normalized modulo 24 hours, e.g. hour 25 is 01:00.
+func NewClock(hour, min, sec, nsec int) Clock {
+	return Clock{}.Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute + time.Duration(sec)*time.Second + time.Duration(nsec))
+}
+
+// ClockOf returns clock of passed time in its location.
+func ClockOf(t time.Time) Clock {
+	hour, min, sec := t.Clock()
+	return NewClock(hour, min, sec, t.Nanosecond())
+}
+
+// IsZero returns true if clock is midnight 00:00.
+func (c Clock) IsZero() bool {
+	return c.ns == 0
+}
+
+// Equal returns true if passed clock is the same value.
+func (c Clock) Equal(o Clock) bool {
+	return c.ns == o.ns
+}
+
+// Clock returns hour, minute and second values.
+func (c Clock) Clock() (hour, min, sec int) {
+	s := int(c.ns / int64(time.Second))
+	return s / 3600, s / 60 % 60, s % 60
+}
+
+// Hour returns hour (0-23).
+func (c Clock) Hour() int {
+	return int(c.ns / int64(time.Hour))
+}
+
+// Minute returns minute (0-59).
+func (c Clock) Minute() int {
+	return int(c.ns / int64(time.Minute) % 60)
+}
+
+// Second returns second (0-59).
+func (c Clock) Second() int {
+	return int(c.ns / int64(time.Second) % 60)
+}
+
+// Nanosecond returns nanosecond offset within the second (0-999999999).
+func (c Clock) Nanosecond() int {
+	return int(c.ns % int64(time.Second))
+}
+
+// Add passed duration to clock.
+// Result wraps around midnight, e.g. 23:00 plus 2 hours is 01:00.
+func (c Clock) Add(d time.Duration) Clock {
+	ns := (c.ns + int64(d)%nsPerDay) % nsPerDay
+	if ns < 0 {
+		ns += nsPerDay
+	}
+	return Clock{ns: ns}
+}
+
+// Sub returns duration from passed clock to current one within the same day.
+// Result is negative if current clock is before passed one.
+func (c Clock) Sub(o Clock) time.Duration {
+	return time.Duration(c.ns - o.ns)
+}
+
+// After returns true if current clock is after passed one.
+func (c Clock) After(o Clock) bool {
+	return c.ns > o.ns
+}
+
+// Before returns true if current clock is before passed one.
+func (c Clock) Before(o Clock) bool {
+	return c.ns < o.ns
+}
+
+// MarshalBinary converts clock to binary representation.
+// It never returns error.
+//
+// Byte positions:
+//   0       1-8
+//   version nanoseconds since midnight
+func (c Clock) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 9)
+	b[0] = version
+	binary.BigEndian.PutUint64(b[1:], uint64(c.ns))
+	return b, nil
+}
+
+// UnmarshalBinary sets clock from passed data.
+// It can return wrapped ErrUnsupportedVersion or ErrInvalidLength.
+func (c *Clock) UnmarshalBinary(data []byte) error {
+	l := len(data)
+	if l == 0 {
+		return fmt.Errorf("date.Clock.UnmarshalBinary: %w: empty data", ErrInvalidLength)
+	}
+	if data[0] != version {
+		return fmt.Errorf("date.Clock.UnmarshalBinary: %w: expected %d instead of %d", ErrUnsupportedVersion, version, data[0])
+	}
+	if l != 9 { // version(1)+nanoseconds(8)
+		return fmt.Errorf("date.Clock.UnmarshalBinary: %w: expected 9 instead of %d", ErrInvalidLength, l)
+	}
+	ns := int64(binary.BigEndian.Uint64(data[1:]))
+	if ns < 0 || ns >= nsPerDay {
+		return fmt.Errorf("date.Clock.UnmarshalBinary: %w: %d", ErrInvalidClock, ns)
+	}
+	c.ns = ns
+	return nil
+}
+
+// MarshalText converts clock to text with ClockFormatter.
+func (c Clock) MarshalText() ([]byte, error) {
+	b, err := ClockFormatter(nil, c, 0)
+	if err != nil {
+		return nil, fmt.Errorf("date.Clock.MarshalText: %w", err)
+	}
+	return b, nil
+}
+
+// UnmarshalText using global ClockParser function.
+func (c *Clock) UnmarshalText(data []byte) error {
+	v, err := ClockParser(data, 0)
+	if err != nil {
+		return fmt.Errorf("date.Clock.UnmarshalText: %w", err)
+	}
+	*c = v
+	return nil
+}
+
+// Format is implementation for fmt.Formatter.
+// Flag # enforce basic format for any verb, e.g. %#S is "204500".
+//
+//   ┌ Verb ┬ Format ────────┬ Example ───┐
+//   │ %b   │ FormatBasic    │ "2045"     │
+//   │ %s   │ Format(0)      │ "20:45"    │
+//   │ %S   │ FormatSeconds  │ "20:45:00" │
+func (c Clock) Format(f fmt.State, verb rune) {
+	format := Format(0)
+	switch verb {
+	case 'b':
+		format = FormatBasic
+	case 'S':
+		format = FormatSeconds
+	}
+	if f.Flag('#') {
+		format |= FormatBasic
+	}
+	f.Write(c.format(format))
+}
+
+// Scan is support for database/sql package.
+// It accepts time.Time and text (string or []byte) parsed by global ClockParser function.
+// It can return wrapped ErrInvalidType or parser error.
+func (c *Clock) Scan(src any) error {
+	b := []byte(nil)
+	switch v := src.(type) {
+	case time.Time:
+		*c = ClockOf(v)
+		return nil
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return fmt.Errorf("date.Clock.Scan: %w: expected time.Time, string or []byte instead of %T", ErrInvalidType, src)
+	}
+	v, err := ClockParser(b, 0)
+	if err != nil {
+		return fmt.Errorf("date.Clock.Scan: %w", err)
+	}
+	*c = v
+	return nil
+}
+
+// Value is support for database/sql package.
+// It always returns text formatted by ClockFormatter with FormatSeconds, e.g. "20:45:00".
+func (c Clock) Value() (driver.Value, error) {
+	b, err := ClockFormatter(nil, c, FormatSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("date.Clock.Value: %w", err)
+	}
+	return string(b), nil
+}
+
+// String formats clock for string output.
+// If ClockFormatter returns error, String returns same value as DefaultClockFormatter.
+func (c Clock) String() string {
+	return string(c.format(0))
+}
+
+func (c Clock) format(f Format) []byte {
+	b, err := ClockFormatter(nil, c, f)
+	if err != nil {
+		b, _ = DefaultClockFormatter(nil, c, f)
+	}
+	return b
+}
+
+// DefaultClockFormatter formats clock.
+// Default format is ISO 8601 extended format with reduced precision, i.e. hh:mm,
+// seconds and fraction of second are added only if they are not zero, i.e. hh:mm:ss or hh:mm:ss.nnn.
+// It reacts to FormatBasic (i.e. hhmm) and FormatSeconds flags and never returns error.
+func DefaultClockFormatter(buf []byte, c Clock, f Format) ([]byte, error) {
+	sep := ":"
+	if f&FormatBasic != 0 {
+		sep = ""
+	}
+	hour, min, sec := c.Clock()
+	ns := c.Nanosecond()
+	if sec == 0 && ns == 0 && f&FormatSeconds == 0 {
+		return internal.Bprintf(buf, "%02d%s%02d", hour, sep, min), nil
+	}
+	buf = internal.Bprintf(buf, "%02d%s%02d%s%02d", hour, sep, min, sep, sec)
+	if ns != 0 {
+		fraction := []byte(fmt.Sprintf("%09d", ns))
+		for fraction[len(fraction)-1] == '0' {
+			fraction = fraction[:len(fraction)-1]
+		}
+		buf = append(append(buf, '.'), fraction...)
+	}
+	return buf, nil
+}
+
+// DefaultClockParser parse Clock from input.
+// Accepted is ISO 8601 time of day in extended (hh:mm, hh:mm:ss) and basic (hhmm, hhmmss) format,
+// optionally with fraction of second separated by dot or comma (e.g. hh:mm:ss.nnn).
+// Time zone designators are not accepted.
+// It reacts to RuleDisableBasic rule.
+func DefaultClockParser[T constraint.ParserInput](input T, r Rule) (Clock, error) {
+	const funcName = "DefaultClockParser"
+	b := []byte(input)
+	l := len(b)
+	if l == 0 {
+		return Clock{}, newParseError(funcName, b, ErrInvalidClock)
+	}
+	if l > clockMaxInputLength {
+		// do not use input for "input too long" error
+		var t T
+		return Clock{}, newParseError(funcName, t, fmt.Errorf("%w: %d > %d", ErrInputTooLong, l, clockMaxInputLength))
+	}
+	parts := patternClock.FindSubmatch(b)
+	if len(parts) == 0 {
+		return Clock{}, newParseError(funcName, input, ErrInvalidClock)
+	}
+	if len(parts[5]) != 0 && len(parts[2]) != len(parts[4]) { // disallow hh:mmss and hhmm:ss formats
+		return Clock{}, newParseError(funcName, input, ErrInvalidClock)
+	}
+	if len(parts[2]) == 0 && r&RuleDisableBasic != 0 {
+		return Clock{}, newParseError(funcName, input, ErrBasicFormatDisabled)
+	}
+	hour, _ := strconv.Atoi(string(parts[1]))
+	min, _ := strconv.Atoi(string(parts[3]))
+	sec, ns := 0, 0
+	if len(parts[5]) != 0 {
+		sec, _ = strconv.Atoi(string(parts[5]))
+	}
+	if fraction := parts[6]; len(fraction) != 0 {
+		ns, _ = strconv.Atoi(string(fraction))
+		for i := len(fraction); i < 9; i++ {
+			ns *= 10
+		}
+	}
+	return NewClock(hour, min, sec, ns), nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.lstv.dev/util/test"
+)
+
+func Test_NewClock(t *testing.T) {
+	c := NewClock(20, 45, 30, 500)
+	hour, min, sec := c.Clock()
+	assert.Equal(t, 20, hour)
+	assert.Equal(t, 45, min)
+	assert.Equal(t, 30, sec)
+	assert.Equal(t, 20, c.Hour())
+	assert.Equal(t, 45, c.Minute())
+	assert.Equal(t, 30, c.Second())
+	assert.Equal(t, 500, c.Nanosecond())
+	assert.Equal(t, NewClock(1, 0, 0, 0), NewClock(25, 0, 0, 0))
+	assert.Equal(t, NewClock(23, 59, 0, 0), NewClock(0, -1, 0, 0))
+	assert.True(t, Clock{}.IsZero())
+	assert.True(t, NewClock(24, 0, 0, 0).IsZero())
+	assert.False(t, c.IsZero())
+}
+
+func Test_ClockOf(t *testing.T) {
+	assert.Equal(t, NewClock(20, 45, 1, 2), ClockOf(time.Date(2026, October, 18, 20, 45, 1, 2, time.FixedZone("+2", 2*60*60))))
+}
+
+func Test_Clock_Add(t *testing.T) {
+	c := NewClock(23, 0, 0, 0)
+	assert.Equal(t, NewClock(1, 0, 0, 0), c.Add(2*time.Hour))
+	assert.Equal(t, NewClock(22, 0, 0, 0), c.Add(-25*time.Hour))
+	assert.Equal(t, c, c.Add(72*time.Hour))
+	assert.Equal(t, 2*time.Hour, c.Sub(NewClock(21, 0, 0, 0)))
+	assert.Equal(t, -22*time.Hour, NewClock(1, 0, 0, 0).Sub(c))
+}
+
+func Test_Clock_compare(t *testing.T) {
+	a, b := NewClock(8, 0, 0, 0), NewClock(8, 0, 0, 1)
+	assert.True(t, a.Before(b))
+	assert.False(t, b.Before(a))
+	assert.False(t, a.Before(a))
+	assert.True(t, b.After(a))
+	assert.False(t, a.After(b))
+	assert.True(t, a.Equal(NewClock(8, 0, 0, 0)))
+	assert.False(t, a.Equal(b))
+}
+
+func Test_Clock_MarshalBinary(t *testing.T) {
+	test.MarshalBinary(t, []test.CaseBinary[Clock]{
+		{ // 0
+			Data:  []byte{1, 0, 0, 0, 0, 0, 0, 0, 0},
+			Value: Clock{},
+		},
+		{ // 1
+			Data:  []byte{1, 0, 0, 0x43, 0xf0, 0x72, 0xf6, 0xf8, 0x00},
+			Value: NewClock(20, 45, 0, 0),
+		},
+	})
+}
+
+func Test_Clock_UnmarshalBinary(t *testing.T) {
+	test.UnmarshalBinary(t, []test.CaseBinary[Clock]{
+		{ // 0
+			Data:  []byte{1, 0, 0, 0x43, 0xf0, 0x72, 0xf6, 0xf8, 0x00},
+			Value: NewClock(20, 45, 0, 0),
+		},
+		{ // 1
+			Error: test.Error("date.Clock.UnmarshalBinary: invalid length: empty data"),
+			Data:  []byte{},
+		},
+		{ // 2
+			Error: test.Error("date.Clock.UnmarshalBinary: unsupported version: expected 1 instead of 2"),
+			Data:  []byte{2, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{ // 3
+			Error: test.Error("date.Clock.UnmarshalBinary: invalid length: expected 9 instead of 2"),
+			Data:  []byte{1, 0},
+		},
+		{ // 4
+			Error: test.Error("date.Clock.UnmarshalBinary: invalid clock: 86400000000000"),
+			Data:  []byte{1, 0, 0, 0x4e, 0x94, 0x91, 0x4f, 0x00, 0x00},
+		},
+	}, nil)
+}
+
+func Test_Clock_MarshalText(t *testing.T) {
+	ClockFormatter = DefaultClockFormatter
+	test.MarshalText(t, []test.CaseText[Clock]{
+		{ // 0
+			Data:  `00:00`,
+			Value: Clock{},
+		},
+		{ // 1
+			Data:  `20:45`,
+			Value: NewClock(20, 45, 0, 0),
+		},
+		{ // 2
+			Data:  `20:45:05`,
+			Value: NewClock(20, 45, 5, 0),
+		},
+		{ // 3
+			Data:  `20:45:05.12`,
+			Value: NewClock(20, 45, 5, 120000000),
+		},
+		{ // 4
+			Data:  `20:45:00.000000001`,
+			Value: NewClock(20, 45, 0, 1),
+		},
+	})
+	ClockFormatter = func(buf []byte, c Clock, f Format) ([]byte, error) {
+		return nil, errors.New("error")
+	}
+	test.MarshalText(t, []test.CaseText[Clock]{
+		{
+			Error: test.Error("date.Clock.MarshalText: error"),
+			Value: NewClock(20, 45, 0, 0),
+		},
+	})
+	assert.Equal(t, "20:45", NewClock(20, 45, 0, 0).String())
+	ClockFormatter = DefaultClockFormatter
+}
+
+func Test_Clock_UnmarshalText(t *testing.T) {
+	test.UnmarshalText(t, []test.CaseText[Clock]{
+		{ // 0
+			Data:  `20:45`,
+			Value: NewClock(20, 45, 0, 0),
+		},
+		{ // 1
+			Data:  `2045`,
+			Value: NewClock(20, 45, 0, 0),
+		},
+		{ // 2
+			Data:  `20:45:05`,
+			Value: NewClock(20, 45, 5, 0),
+		},
+		{ // 3
+			Data:  `204505,5`,
+			Value: NewClock(20, 45, 5, 500000000),
+		},
+		{ // 4
+			Data:  `23:59:59.999999999`,
+			Value: NewClock(23, 59, 59, 999999999),
+		},
+		{ // 5
+			Error: test.Error(`date.Clock.UnmarshalText: date.DefaultClockParser: "24:00": invalid clock`),
+			Data:  `24:00`,
+		},
+		{ // 6
+			Error: test.Error(`date.Clock.UnmarshalText: date.DefaultClockParser: "20:4505": invalid clock`),
+			Data:  `20:4505`,
+		},
+		{ // 7
+			Error: test.Error(`date.Clock.UnmarshalText: date.DefaultClockParser: "20:45Z": invalid clock`),
+			Data:  `20:45Z`,
+		},
+		{ // 8
+			Error: test.Error(`date.Clock.UnmarshalText: date.DefaultClockParser: invalid clock`),
+			Data:  ``,
+		},
+		{ // 9
+			Error: test.Error(`date.Clock.UnmarshalText: date.DefaultClockParser: input too long: 19 > 18`),
+			Data:  `20:45:00.0000000000`,
+		},
+	}, nil)
+}
+
+func Test_DefaultClockParser_rule(t *testing.T) {
+	c, err := DefaultClockParser("2045", RuleDisableBasic)
+	assert.Zero(t, c)
+	assert.EqualError(t, err, `date.DefaultClockParser: "2045": basic format disabled`)
+}
+
+func Test_Clock_Format(t *testing.T) {
+	c := NewClock(20, 45, 0, 0)
+	assert.Equal(t, "20:45", fmt.Sprintf("%s", c))
+	assert.Equal(t, "2045", fmt.Sprintf("%b", c))
+	assert.Equal(t, "20:45:00", fmt.Sprintf("%S", c))
+	assert.Equal(t, "204500", fmt.Sprintf("%#S", c))
+}
+
+func Test_Clock_Scan(t *testing.T) {
+	c := Clock{}
+	require.NoError(t, c.Scan(time.Date(1, January, 1, 20, 45, 0, 0, time.UTC)))
+	assert.Equal(t, NewClock(20, 45, 0, 0), c)
+	require.NoError(t, c.Scan("21:00:00"))
+	assert.Equal(t, NewClock(21, 0, 0, 0), c)
+	require.NoError(t, c.Scan([]byte("22:00")))
+	assert.Equal(t, NewClock(22, 0, 0, 0), c)
+	assert.EqualError(t, c.Scan("x"), `date.Clock.Scan: date.DefaultClockParser: "x": invalid clock`)
+	assert.EqualError(t, c.Scan(1), "date.Clock.Scan: invalid type: expected time.Time, string or []byte instead of int")
+	assert.Equal(t, NewClock(22, 0, 0, 0), c)
+}
+
+func Test_Clock_Value(t *testing.T) {
+	v, err := NewClock(20, 45, 0, 0).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "20:45:00", v)
+}
@@ -9,7 +9,9 @@ package date
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -23,17 +25,35 @@ const (
 	version = 1
 )
 
+// Binary versions written by Date.MarshalBinary depending on BinaryVersion.
+const (
+	// BinaryVersion1 is 7 bytes long: version, year, month and day.
+	BinaryVersion1 = 1
+
+	// BinaryVersion2 is 2-7 bytes long: version and varint of days since 1970-01-01.
+	// Dates between years -900 and 4840 take at most 4 bytes.
+	BinaryVersion2 = 2
+)
+
+// BinaryVersion is version of binary representation written by Date.MarshalBinary.
+// Date.UnmarshalBinary accepts all versions regardless of this setting.
+var BinaryVersion = BinaryVersion1
+
 // Date is representation of date.
 // Zero date is valid, representing value 0001-01-01.
+// Proleptic Gregorian calendar and astronomical year numbering are used, i.e. year 0 is 1 BC, year -1 is 2 BC and so on.
+// Supported years are from -2147483647 to 2147483648 (year minus one fits int32).
+// See FormatExpanded and RuleEnableExpanded for text form of years out of range 0-9999.
 type Date struct {
 	year  int32
 	month uint8
 	day   uint8
 }
 
-// Today returns actual date.
+// Today returns actual date in location of time returned by Now, i.e. time.Local by default.
+// Use TodayIn to get actual date in a specific location.
 func Today() Date {
-	return FromTime(time.Now())
+	return FromTime(Now())
 }
 
 // New creates date with specific year, month and day.
@@ -41,7 +61,25 @@ func New(year int, month Month, day int) Date {
 	return FromTime(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
 }
 
+// NewISOWeek creates date from ISO 8601 week-numbering year, week and weekday.
+// Week 1 is the week with the year's first Thursday, weeks start on Monday.
+// Values out of range are normalized the same way as New does.
+func NewISOWeek(year, week int, weekday Weekday) Date {
+	jan4 := New(year, January, 4)
+	return jan4.Add(0, 0, (week-1)*7+isoWeekday(weekday)-isoWeekday(jan4.Weekday()))
+}
+
+// NewYearDay creates date from year and day of the year (1-365 or 1-366 in leap years).
+// Values out of range are normalized the same way as New does.
+func NewYearDay(year, day int) Date {
+	return New(year, January, day)
+}
+
 // FromTime creates date from time.Time value.
+// Date is taken in location of passed time without any conversion,
+// e.g. 2026-10-18T23:30:00Z is 2026-10-18 even if it is already 2026-10-19 in Europe/Prague.
+// Use t.In(loc) to get date in other location.
+// Zero time.Time is converted to zero date regardless of its location.
 func FromTime(t time.Time) Date {
 	d := Date{}
 	d.FromTime(t)
@@ -55,17 +93,17 @@ func (d Date) IsZero() bool {
 
 // Equal returns true if passed date is the same value.
 func (d Date) Equal(e Date) bool {
-	return d.year == e.year && d.month == e.month && d.day == e.day
+	return Compare(d, e) == 0
 }
 
 // Date returns year, month and day values.
 func (d Date) Date() (year int, month Month, day int) {
-	return int(d.year + 1), Month(d.month + 1), int(d.day + 1)
+	return int(d.year) + 1, Month(d.month + 1), int(d.day + 1)
 }
 
 // Year returns date year.
 func (d Date) Year() int {
-	return int(d.year + 1)
+	return int(d.year) + 1
 }
 
 // Month returns date month (from January to December).
@@ -78,13 +116,47 @@ func (d Date) Day() int {
 	return int(d.day + 1)
 }
 
+// Weekday returns day of the week.
+func (d Date) Weekday() Weekday {
+	w := (d.DayNumber() + 1) % 7 // 0001-01-01 is Monday
+	if w < 0 {
+		w += 7
+	}
+	return Weekday(w)
+}
+
+// YearDay returns day of the year (1-365 or 1-366 in leap years).
+func (d Date) YearDay() int {
+	return d.Time().YearDay()
+}
+
+// ISOWeek returns ISO 8601 week-numbering year and week (1-53).
+// Dates from January 1 to January 3 might belong to the last week of previous year
+// and dates from December 29 to December 31 might belong to week 1 of next year.
+func (d Date) ISOWeek() (year, week int) {
+	return d.Time().ISOWeek()
+}
+
 // Time returns time.Time object based on date value.
 // Time is midnight (0:00:00.0) and zone is time.UTC.
 func (d Date) Time() time.Time {
-	return time.Date(int(d.year+1), time.Month(d.month+1), int(d.day+1), 0, 0, 0, 0, time.UTC)
+	return time.Date(int(d.year)+1, time.Month(d.month+1), int(d.day+1), 0, 0, 0, 0, time.UTC)
+}
+
+// FromDayNumber creates date from count of days since 0001-01-01 (see Date.DayNumber).
+// Day numbers out of range of Date (years of int32) are not supported.
+func FromDayNumber(n int64) Date {
+	return fromUnixDays(n - unixToDayNumber)
+}
+
+// FromJulianDay creates date from Julian Day Number (see Date.JulianDay).
+// Day numbers out of range of Date (years of int32) are not supported.
+func FromJulianDay(jdn int64) Date {
+	return FromDayNumber(jdn - dayNumberToJulianDay)
 }
 
 // FromTime sets date year, month and day from passed time.Time value.
+// Conversion rules are the same as FromTime function has.
 func (d *Date) FromTime(t time.Time) {
 	if t.IsZero() {
 		d.year = 0
@@ -98,9 +170,173 @@ func (d *Date) FromTime(t time.Time) {
 	d.day = uint8(day - 1)
 }
 
+// DayNumber returns count of days since 0001-01-01, i.e. zero date is day 0 (rata die minus one).
+// It is exact for the whole range of Date.
+func (d Date) DayNumber() int64 {
+	return d.unixDays() + unixToDayNumber
+}
+
+// JulianDay returns Julian Day Number (JDN), i.e. count of days since noon of November 24, 4714 BC
+// in the proleptic Gregorian calendar. Date 0001-01-01 is day 1721426.
+func (d Date) JulianDay() int64 {
+	return d.DayNumber() + dayNumberToJulianDay
+}
+
 // Add passed values to date.
+// Years and months are added first, overflowing day is normalized the same way as New does,
+// e.g. 2026-01-31 plus one month is 2026-03-03.
+// Result is computed arithmetically (without time.Time), overflow of year wraps around int32.
+// See AddMonths and AddYears for other behaviors of day overflow.
 func (d Date) Add(years int, months int, days int) Date {
-	return FromTime(d.Time().AddDate(years, months, days))
+	if years == 0 && months == 0 {
+		return FromDayNumber(d.DayNumber() + int64(days))
+	}
+	year, month := d.shiftMonth(int64(years)*12 + int64(months))
+	return fromUnixDays(daysFromCivil(year, month, 1) + int64(d.day) + int64(days))
+}
+
+// AddMonths adds passed count of months to date.
+// If day of month does not exist in resulting month, result depends on passed policy.
+// It can return wrapped ErrDayOutOfMonth if MonthReject policy is used.
+func (d Date) AddMonths(months int, policy MonthPolicy) (Date, error) {
+	v, err := d.addMonths(int64(months), policy)
+	if err != nil {
+		return Date{}, fmt.Errorf("date.Date.AddMonths: %w", err)
+	}
+	return v, nil
+}
+
+// AddYears adds passed count of years to date.
+// If day of month does not exist in resulting year (February 29), result depends on passed policy.
+// It can return wrapped ErrDayOutOfMonth if MonthReject policy is used.
+func (d Date) AddYears(years int, policy MonthPolicy) (Date, error) {
+	v, err := d.addMonths(int64(years)*12, policy)
+	if err != nil {
+		return Date{}, fmt.Errorf("date.Date.AddYears: %w", err)
+	}
+	return v, nil
+}
+
+func (d Date) addMonths(months int64, policy MonthPolicy) (Date, error) {
+	year, month := d.shiftMonth(months)
+	day := int64(d.day) + 1
+	if n := monthLength(year, month); day > n {
+		switch policy {
+		case MonthClamp:
+			day = n
+		case MonthReject:
+			return Date{}, fmt.Errorf("%w: %04d-%02d-%02d", ErrDayOutOfMonth, year, month, day)
+		}
+	}
+	return fromUnixDays(daysFromCivil(year, month, 1) + day - 1), nil
+}
+
+// shiftMonth returns year and month (1-12) of date moved by passed count of months.
+func (d Date) shiftMonth(months int64) (year, month int64) {
+	m := int64(d.year)*12 + int64(d.month) + months
+	y := m / 12
+	if m%12 < 0 {
+		y--
+	}
+	return y + 1, m - y*12 + 1
+}
+
+// StartOfMonth returns the first day of month.
+func (d Date) StartOfMonth() Date {
+	return Date{year: d.year, month: d.month}
+}
+
+// EndOfMonth returns the last day of month.
+func (d Date) EndOfMonth() Date {
+	return Date{year: d.year, month: d.month, day: uint8(monthLength(int64(d.year)+1, int64(d.month)+1) - 1)}
+}
+
+// StartOfWeek returns the first day of week, weeks start on passed weekday.
+// ISO 8601 weeks start on Monday.
+func (d Date) StartOfWeek(firstDay Weekday) Date {
+	return d.Add(0, 0, -((int(d.Weekday())-int(firstDay))%7+7)%7)
+}
+
+// StartOfQuarter returns the first day of quarter, i.e. January 1, April 1, July 1 or October 1.
+func (d Date) StartOfQuarter() Date {
+	return Date{year: d.year, month: d.month / 3 * 3}
+}
+
+// StartOfYear returns January 1 of year.
+func (d Date) StartOfYear() Date {
+	return Date{year: d.year}
+}
+
+// AddPeriod adds passed period to date, weeks are added as 7 days.
+// It is the same as Add(p.Years, p.Months, 7*p.Weeks+p.Days).
+func (d Date) AddPeriod(p Period) Date {
+	return d.Add(p.Years, p.Months, 7*p.Weeks+p.Days)
+}
+
+// PeriodUntil returns calendar difference from current date to passed one as years, months and days.
+// Whole months are counted first, remaining days second, all components have the same sign.
+// Result is consistent with AddPeriod, i.e. d.AddPeriod(d.PeriodUntil(e)) is always e,
+// so for example from 2026-01-31 to 2026-03-01 is P29D, because 2026-01-31 plus one month is 2026-03-03.
+func (d Date) PeriodUntil(e Date) Period {
+	months := (int(e.year)-int(d.year))*12 + int(e.month) - int(d.month)
+	if e.Before(d) {
+		for months < 0 && d.Add(0, months, 0).Before(e) {
+			months++
+		}
+	} else {
+		for months > 0 && d.Add(0, months, 0).After(e) {
+			months--
+		}
+	}
+	return Period{
+		Years:  months / 12,
+		Months: months % 12,
+		Days:   e.DaysBetween(d.Add(0, months, 0)),
+	}
+}
+
+// Anniversary returns date with the same month and day in passed year, e.g. birthday.
+// If day does not exist in passed year (February 29), result depends on passed policy.
+func (d Date) Anniversary(year int, policy LeapDayPolicy) Date {
+	return d.anniversary((int64(year)-int64(d.year)-1)*12, policy)
+}
+
+// YearsBetween returns count of whole years between passed date and current one, e.g. age:
+//   today.YearsBetween(birthday, LeapDayFebruary28)
+// Year is complete on anniversary of passed date, anniversary of February 29 in common year depends on passed policy.
+// Periods are counted from the earlier date, result is negative if current date is before passed one.
+func (d Date) YearsBetween(e Date, policy LeapDayPolicy) int {
+	return int(d.periodsBetween(e, 12, policy))
+}
+
+// MonthsBetween returns count of whole months between passed date and current one.
+// Month is complete on the same day of month as passed date has,
+// if the day does not exist in month (e.g. 31), result depends on passed policy.
+// Periods are counted from the earlier date, result is negative if current date is before passed one.
+func (d Date) MonthsBetween(e Date, policy LeapDayPolicy) int {
+	return int(d.periodsBetween(e, 1, policy))
+}
+
+// periodsBetween returns count of whole periods of passed months between passed date and current one.
+func (d Date) periodsBetween(e Date, months int64, policy LeapDayPolicy) int64 {
+	if d.Before(e) {
+		return -e.periodsBetween(d, months, policy)
+	}
+	n := ((int64(d.year)-int64(e.year))*12 + int64(d.month) - int64(e.month)) / months
+	if e.anniversary(n*months, policy).After(d) {
+		n--
+	}
+	return n
+}
+
+// anniversary returns date moved by passed count of months, missing day of month is resolved by policy.
+// LeapDayFebruary28 is the same as MonthClamp, LeapDayMarch1 moves clamped day to the first day of next month.
+func (d Date) anniversary(months int64, policy LeapDayPolicy) Date {
+	v, _ := d.addMonths(months, MonthClamp) // never fails
+	if policy == LeapDayMarch1 && v.day != d.day {
+		return fromUnixDays(v.unixDays() + 1)
+	}
+	return v
 }
 
 // AddDuration add passed duration to date.
@@ -111,93 +347,103 @@ func (d Date) AddDuration(duration time.Duration) Date {
 // After returns true if passed date is after current one.
 // Otherwise, and also if dates are equal, returns false.
 func (d Date) After(e Date) bool {
-	if d.year > e.year {
-		return true
-	}
-	if d.year < e.year {
-		return false
-	}
-	if d.month > e.month {
-		return true
-	}
-	if d.month < e.month {
-		return false
-	}
-	if d.day > e.day {
-		return true
-	}
-	return false
+	return Compare(d, e) > 0
 }
 
 // Before return true if passed date is before current one.
 // Otherwise, and also if dates are equal, returns false.
 func (d Date) Before(e Date) bool {
-	if d.year < e.year {
-		return true
-	}
-	if d.year > e.year {
-		return false
-	}
-	if d.month < e.month {
-		return true
-	}
-	if d.month > e.month {
-		return false
-	}
-	if d.day < e.day {
-		return true
-	}
-	return false
+	return Compare(d, e) < 0
 }
 
 // Sub subtracts passed date and returns duration between them.
 // Returned duration is value between their midnights.
+// If dates are more than about 292 years apart, the maximum (or minimum) duration is returned
+// the same way as time.Time.Sub does, use DaysBetween instead.
 func (d Date) Sub(e Date) time.Duration {
-	return d.Time().Sub(e.Time())
+	const maxDays = int64(math.MaxInt64 / (24 * time.Hour))
+	switch days := d.DayNumber() - e.DayNumber(); {
+	case days > maxDays:
+		return math.MaxInt64
+	case days < -maxDays:
+		return math.MinInt64
+	default:
+		return time.Duration(days) * 24 * time.Hour
+	}
 }
 
 // DaysBetween returns count of days between passed date and current one.
-// Result is based on value between their midnights and can be affected by maximum under-laying data type values.
+// It is exact for the whole range of Date if int is 64-bit. On 32-bit platforms it overflows
+// if dates are more than about 5.8 million years apart, use difference of DayNumber values instead.
 func (d Date) DaysBetween(e Date) int {
-	return int(d.Time().Sub(e.Time()).Hours() / 24)
+	return int(d.DayNumber() - e.DayNumber())
 }
 
-// MarshalBinary converts date to binary representation.
-// It never returns error.
+// MarshalBinary converts date to binary representation of BinaryVersion.
+// It returns wrapped ErrUnsupportedVersion only if BinaryVersion is not supported.
 //
-// Byte positions:
+// Byte positions of BinaryVersion1:
 //   0       1-4  5     6
 //   version year month day
+//
+// Byte positions of BinaryVersion2:
+//   0       1-6
+//   version varint of days since 1970-01-01 (see encoding/binary.PutVarint)
 func (d Date) MarshalBinary() ([]byte, error) {
+	switch BinaryVersion {
+	case BinaryVersion1:
+		return d.binaryV1(), nil
+	case BinaryVersion2:
+		b := make([]byte, 1+binary.MaxVarintLen64)
+		b[0] = BinaryVersion2
+		n := binary.PutVarint(b[1:], d.unixDays())
+		return b[:1+n], nil
+	}
+	return nil, fmt.Errorf("date.Date.MarshalBinary: %w: %d", ErrUnsupportedVersion, BinaryVersion)
+}
+
+// binaryV1 returns BinaryVersion1 representation used by Date and DateTime.
+func (d Date) binaryV1() []byte {
 	y := d.year + 1
 	return []byte{
-		version,
+		BinaryVersion1,
 		byte(y >> 24),
 		byte(y >> 16),
 		byte(y >> 8),
 		byte(y),
 		d.month + 1,
 		d.day + 1,
-	}, nil
+	}
 }
 
-// UnmarshalBinary sets date from passed data.
-// It can return wrapped ErrUnsupportedVersion or ErrInvalidLength.
+// UnmarshalBinary sets date from passed data of any supported version.
+// It can return wrapped ErrUnsupportedVersion, ErrInvalidLength or ErrOutOfRange.
 func (d *Date) UnmarshalBinary(data []byte) error {
 	l := len(data)
 	if l == 0 {
 		return fmt.Errorf("date.Date.UnmarshalBinary: %w: empty data", ErrInvalidLength)
 	}
-	if data[0] != version {
-		return fmt.Errorf("date.Date.UnmarshalBinary: %w: expected %d instead of %d", ErrUnsupportedVersion, version, data[0])
-	}
-	if l != 7 { // version(1)+year(4)+month(1)+day(1)
-		return fmt.Errorf("date.Date.UnmarshalBinary: %w: expected 7 instead of %d", ErrInvalidLength, l)
+	switch data[0] {
+	case BinaryVersion1:
+		if l != 7 { // version(1)+year(4)+month(1)+day(1)
+			return fmt.Errorf("date.Date.UnmarshalBinary: %w: expected 7 instead of %d", ErrInvalidLength, l)
+		}
+		d.year = (int32(data[1])<<24 | int32(data[2])<<16 | int32(data[3])<<8 | int32(data[4])) - 1
+		d.month = data[5] - 1
+		d.day = data[6] - 1
+		return nil
+	case BinaryVersion2:
+		days, n := binary.Varint(data[1:])
+		if n <= 0 || 1+n != l {
+			return fmt.Errorf("date.Date.UnmarshalBinary: %w: invalid varint", ErrInvalidLength)
+		}
+		if days < minUnixDays || days > maxUnixDays {
+			return fmt.Errorf("date.Date.UnmarshalBinary: %w: %d days", ErrOutOfRange, days)
+		}
+		*d = fromUnixDays(days)
+		return nil
 	}
-	d.year = (int32(data[1])<<24 | int32(data[2])<<16 | int32(data[3])<<8 | int32(data[4])) - 1
-	d.month = data[5] - 1
-	d.day = data[6] - 1
-	return nil
+	return fmt.Errorf("date.Date.UnmarshalBinary: %w: expected %d or %d instead of %d", ErrUnsupportedVersion, BinaryVersion1, BinaryVersion2, data[0])
 }
 
 // MarshalText converts date to text with Formatter.
@@ -209,9 +455,9 @@ func (d Date) MarshalText() ([]byte, error) {
 	return b, nil
 }
 
-// UnmarshalText using global Parser function.
+// UnmarshalText using global Parser function with DefaultRule.
 func (d *Date) UnmarshalText(data []byte) error {
-	date, err := Parser(data, 0)
+	date, err := Parser(data, DefaultRule)
 	if err != nil {
 		return fmt.Errorf("date.Date.UnmarshalText: %w", err)
 	}
@@ -219,28 +465,99 @@ func (d *Date) UnmarshalText(data []byte) error {
 	return nil
 }
 
+// MarshalJSON converts date to JSON value.
+// If DisableMarshalJSONStringForm is false, JSON string formatted by Formatter is used (e.g. "2026-10-18").
+// Otherwise, if DisableMarshalJSONObjectForm is false, JSON object form is used.
+// Otherwise, if DisableMarshalJSONUnixForm is false, Unix time of midnight in UTC in seconds is used (e.g. 1792281600).
+// Otherwise, Unix time of midnight in UTC in milliseconds is used (e.g. 1792281600000),
+// it returns wrapped ErrOutOfRange if it does not fit to int64.
+//
+// Example of JSON object form for 2026-10-18:
+//   {
+//     "year": 2026,
+//     "month": 10,
+//     "day": 18
+//   }
+//
+// See also DisableMarshalJSONStringForm, DisableMarshalJSONObjectForm and DisableMarshalJSONUnixForm.
+func (d Date) MarshalJSON() ([]byte, error) {
+	b, err := d.marshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("date.Date.MarshalJSON: %w", err)
+	}
+	return b, nil
+}
+
+// UnmarshalJSON parses date from JSON value.
+// JSON string is parsed using global Parser function, other forms depend on DefaultRule:
+// JSON object requires RuleEnableJSONObjectForm and JSON number requires RuleEnableJSONUnixForm
+// or RuleEnableJSONUnixMilliForm (Unix time is converted to date in UTC).
+// JSON null is ignored, unless RuleEnableJSONNull is present, in which case date is set to zero value.
+// It can return wrapped ErrObjectFormDisabled, ErrUnixFormDisabled, ErrInvalidObject, ErrInvalidType, ErrOutOfRange or parser error.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		if DefaultRule&RuleEnableJSONNull != 0 {
+			*d = Date{}
+		}
+		return nil
+	}
+	date, err := unmarshalJSON(data, DefaultRule)
+	if err != nil {
+		return fmt.Errorf("date.Date.UnmarshalJSON: %w", err)
+	}
+	*d = date
+	return nil
+}
+
 // Format is implementation for fmt.Formatter.
+// Flag # enforce basic format for any verb, e.g. %#W is "2006W011".
 //
-//   ┌ Verb ┬ Format ─────┬ Example ─────┐
-//   │ %b   │ FormatBasic │ "20060102"   │
-//   │ %e   │ Format(0)   │ "2006-01-02" │
-//   │ %s   │ Format(0)   │ "2006-01-02" │
+//   ┌ Verb ┬ Format ────────┬ Example ─────────────────┐
+//   │ %b   │ FormatBasic    │ "20060102"               │
+//   │ %e   │ Format(0)      │ "2006-01-02"             │
+//   │ %s   │ Format(0)      │ "2006-01-02"             │
+//   │ %W   │ FormatWeek     │ "2006-W01-1"             │
+//   │ %O   │ FormatOrdinal  │ "2006-002"               │
+//   │ %L   │ FormatLong     │ "Monday, 2 January 2006" │
 func (d Date) Format(f fmt.State, verb rune) {
-	f.Write(d.format(formatByVerb(verb)))
+	format := formatByVerb(verb)
+	if f.Flag('#') {
+		format |= FormatBasic
+	}
+	f.Write(d.format(format))
 }
 
 // Scan is support for database/sql package.
-// It can return wrapped ErrInvalidType.
+// It accepts time.Time, text (string or []byte) parsed by global Parser function with DefaultRule
+// and integer (int64 or int) converted by ScanIntForm.
+// It can return wrapped ErrInvalidType, ErrOutOfRange (for count of days out of range of Date) or parser error.
 func (d *Date) Scan(src any) error {
-	if t, ok := src.(time.Time); ok {
-		d.FromTime(t)
+	switch v := src.(type) {
+	case time.Time:
+		d.FromTime(v)
 		return nil
+	case string:
+		return d.scanText([]byte(v))
+	case []byte:
+		return d.scanText(v)
+	case int64:
+		return d.scanInt(v)
+	case int:
+		return d.scanInt(int64(v))
 	}
-	return fmt.Errorf("date.Date.Scan: %w: expected time.Time instead of %T", ErrInvalidType, src)
+	return fmt.Errorf("date.Date.Scan: %w: expected time.Time, string, []byte or integer instead of %T", ErrInvalidType, src)
 }
 
 // Value is support for database/sql package.
+// It returns time.Time or string depending on SQLValueForm.
 func (d Date) Value() (driver.Value, error) {
+	if SQLValueForm == ValueFormText {
+		b, err := Formatter(nil, d, 0)
+		if err != nil {
+			return nil, fmt.Errorf("date.Date.Value: %w", err)
+		}
+		return string(b), nil
+	}
 	return d.Time(), nil
 }
 
@@ -250,6 +567,24 @@ func (d Date) String() string {
 	return string(d.format(0))
 }
 
+func (d *Date) scanText(data []byte) error {
+	date, err := Parser(data, DefaultRule)
+	if err != nil {
+		return fmt.Errorf("date.Date.Scan: %w", err)
+	}
+	*d = date
+	return nil
+}
+
+func (d *Date) scanInt(v int64) error {
+	date, err := scanInt(v)
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
+
 func (d Date) format(f Format) []byte {
 	b, err := Formatter(nil, d, f)
 	if err != nil {
@@ -264,7 +599,89 @@ func formatByVerb(verb rune) Format {
 		return FormatBasic
 	case 'e':
 		return 0
+	case 'W':
+		return FormatWeek
+	case 'O':
+		return FormatOrdinal
+	case 'L':
+		return FormatLong
 	default:
 		return 0
 	}
 }
+
+// isoWeekday returns ISO 8601 weekday number, i.e. 1 for Monday and 7 for Sunday.
+func isoWeekday(w Weekday) int {
+	if w == Sunday {
+		return 7
+	}
+	return int(w)
+}
+
+// Range of days since 1970-01-01 representable by Date.
+var (
+	minUnixDays = Date{year: math.MinInt32}.unixDays()
+	maxUnixDays = Date{year: math.MaxInt32, month: 11, day: 30}.unixDays()
+)
+
+// Offsets between day counts.
+const (
+	unixToDayNumber      = 719162  // days from 0001-01-01 to 1970-01-01
+	dayNumberToJulianDay = 1721426 // Julian Day Number of 0001-01-01
+)
+
+// unixDays returns count of days since 1970-01-01 (negative for earlier dates).
+func (d Date) unixDays() int64 {
+	return daysFromCivil(int64(d.year)+1, int64(d.month)+1, int64(d.day)+1)
+}
+
+// monthLength returns count of days in month (1-12) of year.
+func monthLength(year, month int64) int64 {
+	switch month {
+	case 2:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	}
+	return 31
+}
+
+// daysFromCivil returns count of days since 1970-01-01 of passed year, month (1-12) and day (1-31).
+// It uses days from civil algorithm by Howard Hinnant, see http://howardhinnant.github.io/date_algorithms.html.
+func daysFromCivil(y, m, day int64) int64 {
+	if m <= 2 {
+		y--
+	}
+	era := y
+	if era < 0 {
+		era -= 399
+	}
+	era /= 400
+	yoe := y - era*400                     // [0, 399]
+	doy := (153*((m+9)%12)+2)/5 + day - 1  // [0, 365]
+	doe := yoe*365 + yoe/4 - yoe/100 + doy // [0, 146096]
+	return era*146097 + doe - 719468
+}
+
+// fromUnixDays is inverse function to Date.unixDays.
+// Days must be in range of Date, i.e. between minUnixDays and maxUnixDays.
+func fromUnixDays(days int64) Date {
+	z := days + 719468
+	era := z
+	if era < 0 {
+		era -= 146096
+	}
+	era /= 146097
+	doe := z - era*146097                                  // [0, 146096]
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365 // [0, 399]
+	doy := doe - (365*yoe + yoe/4 - yoe/100)               // [0, 365]
+	mp := (5*doy + 2) / 153                                // [0, 11], March is 0
+	y, m := yoe+era*400, (mp+2)%12+1
+	if m <= 2 {
+		y++
+	}
+	return Date{year: int32(y - 1), month: uint8(m - 1), day: uint8(doy - (153*mp+2)/5)}
+}
@@ -5,15 +5,18 @@
 package date
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
 	"go.lstv.dev/util/test"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func assertDate(t *testing.T, expectedYear int, expectedMonth Month, expectedDay int, date Date) {
@@ -36,6 +39,20 @@ func Test_Date(t *testing.T) {
 	assertDate(t, 2002, August, 7, FromTime(time.Date(2002, August, 7, 15, 12, 55, 7, time.UTC)))
 }
 
+func Test_NewISOWeek(t *testing.T) {
+	assertDate(t, 2026, October, 14, NewISOWeek(2026, 42, Wednesday))
+	assertDate(t, 2026, October, 18, NewISOWeek(2026, 42, Sunday))
+	assertDate(t, 2021, January, 1, NewISOWeek(2020, 53, Friday))
+	assertDate(t, 2008, December, 29, NewISOWeek(2009, 1, Monday))
+	assertDate(t, 2021, January, 4, NewISOWeek(2020, 54, Monday))
+}
+
+func Test_NewYearDay(t *testing.T) {
+	assertDate(t, 2026, October, 18, NewYearDay(2026, 291))
+	assertDate(t, 2020, December, 31, NewYearDay(2020, 366))
+	assertDate(t, 2022, January, 1, NewYearDay(2021, 366))
+}
+
 func Test_Today(t *testing.T) {
 	date := Today()
 	year, month, day := time.Now().Date()
@@ -65,6 +82,32 @@ func Test_Date_Date(t *testing.T) {
 	assert.Equal(t, 7, date.Day())
 }
 
+func Test_Date_Weekday(t *testing.T) {
+	assert.Equal(t, Monday, Date{}.Weekday())
+	assert.Equal(t, Sunday, New(2026, October, 18).Weekday())
+	for d := New(-400, January, 1); d.Before(New(1, January, 8)); d = d.Add(0, 0, 1) {
+		require.Equal(t, d.Time().Weekday(), d.Weekday(), d)
+	}
+}
+
+func Test_Date_YearDay(t *testing.T) {
+	assert.Equal(t, 1, Date{}.YearDay())
+	assert.Equal(t, 291, New(2026, October, 18).YearDay())
+	assert.Equal(t, 366, New(2020, December, 31).YearDay())
+}
+
+func Test_Date_ISOWeek(t *testing.T) {
+	year, week := New(2026, October, 18).ISOWeek()
+	assert.Equal(t, 2026, year)
+	assert.Equal(t, 42, week)
+	year, week = New(2021, January, 1).ISOWeek()
+	assert.Equal(t, 2020, year)
+	assert.Equal(t, 53, week)
+	year, week = New(2008, December, 29).ISOWeek()
+	assert.Equal(t, 2009, year)
+	assert.Equal(t, 1, week)
+}
+
 func Test_Date_Time(t *testing.T) {
 	expectedTime := time.Date(2002, August, 7, 0, 0, 0, 0, time.UTC)
 	timeWithLocation := time.Date(2002, August, 7, 14, 12, 55, 7, time.FixedZone("+1", 60*60))
@@ -82,6 +125,153 @@ func Test_Date_Add(t *testing.T) {
 	assertDate(t, 2004, October, 8, New(2002, August, 7).Add(2, 2, 1))
 	assertDate(t, 2004, April, 7, New(2002, August, 7).Add(0, 20, 0))
 	assertDate(t, 2000, December, 7, New(2002, August, 7).Add(0, -20, 0))
+	assertDate(t, 2026, March, 3, New(2026, January, 31).Add(0, 1, 0))
+	assertDate(t, 2025, December, 31, New(2026, January, 1).Add(0, 0, -1))
+	assertDate(t, 2024, February, 29, New(2028, February, 29).Add(-4, 0, 0))
+	d := New(2026, October, 18)
+	for _, v := range [][3]int{{0, 0, 100000}, {0, 0, -800000}, {-3000, 7, -31}, {10, -250, 45}, {0, 13, 0}, {-1, -1, -1}} {
+		assert.Equal(t, FromTime(d.Time().AddDate(v[0], v[1], v[2])), d.Add(v[0], v[1], v[2]), v)
+	}
+	assert.Equal(t, Date{year: math.MaxInt32, month: 11, day: 30}, Date{year: math.MaxInt32, month: 11, day: 29}.Add(0, 0, 1))
+	assert.Equal(t, Date{year: math.MinInt32}, Date{year: math.MinInt32, day: 1}.Add(0, 0, -1))
+	assert.Equal(t, 0.0, testing.AllocsPerRun(10, func() {
+		d.Add(0, 0, 1)
+	}))
+}
+
+func Test_Date_AddMonths(t *testing.T) {
+	for _, c := range []struct {
+		d        Date
+		months   int
+		policy   MonthPolicy
+		expected Date
+	}{
+		{New(2026, January, 31), 1, MonthOverflow, New(2026, March, 3)},
+		{New(2026, January, 31), 1, MonthClamp, New(2026, February, 28)},
+		{New(2024, January, 31), 1, MonthClamp, New(2024, February, 29)},
+		{New(2026, January, 31), 2, MonthReject, New(2026, March, 31)},
+		{New(2026, March, 31), -1, MonthClamp, New(2026, February, 28)},
+		{New(2026, October, 18), -22, MonthReject, New(2024, December, 18)},
+		{New(2026, August, 31), 13, MonthClamp, New(2027, September, 30)},
+	} {
+		v, err := c.d.AddMonths(c.months, c.policy)
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, v, "%s %+d", c.d, c.months)
+	}
+	v, err := New(2026, January, 31).AddMonths(1, MonthReject)
+	assert.Zero(t, v)
+	assert.EqualError(t, err, "date.Date.AddMonths: day out of month: 2026-02-31")
+	assert.True(t, errors.Is(err, ErrDayOutOfMonth))
+}
+
+func Test_Date_AddYears(t *testing.T) {
+	leap := New(2024, February, 29)
+	v, err := leap.AddYears(1, MonthOverflow)
+	require.NoError(t, err)
+	assert.Equal(t, New(2025, March, 1), v)
+	v, err = leap.AddYears(1, MonthClamp)
+	require.NoError(t, err)
+	assert.Equal(t, New(2025, February, 28), v)
+	v, err = leap.AddYears(-4, MonthReject)
+	require.NoError(t, err)
+	assert.Equal(t, New(2020, February, 29), v)
+	v, err = leap.AddYears(1, MonthReject)
+	assert.Zero(t, v)
+	assert.EqualError(t, err, "date.Date.AddYears: day out of month: 2025-02-29")
+}
+
+func Test_Date_Anniversary(t *testing.T) {
+	leap := New(2024, February, 29)
+	assert.Equal(t, New(2025, February, 28), leap.Anniversary(2025, LeapDayFebruary28))
+	assert.Equal(t, New(2025, March, 1), leap.Anniversary(2025, LeapDayMarch1))
+	assert.Equal(t, New(2028, February, 29), leap.Anniversary(2028, LeapDayMarch1))
+	assert.Equal(t, New(1900, February, 28), leap.Anniversary(1900, LeapDayFebruary28))
+	assert.Equal(t, New(-44, March, 15), New(2026, March, 15).Anniversary(-44, LeapDayMarch1))
+	assert.Equal(t, New(2026, October, 18), New(1990, October, 18).Anniversary(2026, LeapDayFebruary28))
+}
+
+func Test_Date_YearsBetween(t *testing.T) {
+	leap := New(2024, February, 29)
+	for _, c := range []struct {
+		d, e     Date
+		policy   LeapDayPolicy
+		expected int
+	}{
+		{New(2026, October, 18), New(1990, October, 18), LeapDayFebruary28, 36},
+		{New(2026, October, 17), New(1990, October, 18), LeapDayFebruary28, 35},
+		{New(1990, October, 18), New(2026, October, 17), LeapDayFebruary28, -35},
+		{New(2026, October, 18), New(2026, October, 18), LeapDayFebruary28, 0},
+		{New(2026, January, 1), New(2025, December, 31), LeapDayFebruary28, 0},
+		{New(2025, February, 28), leap, LeapDayFebruary28, 1},
+		{New(2025, February, 28), leap, LeapDayMarch1, 0},
+		{New(2025, March, 1), leap, LeapDayMarch1, 1},
+		{New(2028, February, 28), leap, LeapDayFebruary28, 3},
+		{New(2028, February, 29), leap, LeapDayMarch1, 4},
+		{New(2025, February, 28), New(2024, February, 28), LeapDayMarch1, 1},
+		{leap, New(2023, March, 1), LeapDayFebruary28, 0},
+		{New(2026, March, 15), New(-44, March, 15), LeapDayFebruary28, 2070},
+	} {
+		assert.Equal(t, c.expected, c.d.YearsBetween(c.e, c.policy), "%s - %s", c.d, c.e)
+	}
+}
+
+func Test_Date_MonthsBetween(t *testing.T) {
+	for _, c := range []struct {
+		d, e     Date
+		policy   LeapDayPolicy
+		expected int
+	}{
+		{New(2026, October, 18), New(2026, September, 18), LeapDayFebruary28, 1},
+		{New(2026, October, 17), New(2026, September, 18), LeapDayFebruary28, 0},
+		{New(2026, October, 18), New(2025, October, 18), LeapDayFebruary28, 12},
+		{New(2026, February, 28), New(2026, January, 31), LeapDayFebruary28, 1},
+		{New(2026, February, 28), New(2026, January, 31), LeapDayMarch1, 0},
+		{New(2026, March, 1), New(2026, January, 31), LeapDayMarch1, 1},
+		{New(2026, March, 30), New(2026, January, 31), LeapDayMarch1, 1},
+		{New(2026, March, 31), New(2026, January, 31), LeapDayMarch1, 2},
+		{New(2026, April, 30), New(2026, January, 31), LeapDayFebruary28, 3},
+		{New(2026, January, 31), New(2026, April, 30), LeapDayFebruary28, -3},
+		{New(2026, January, 31), New(2026, March, 31), LeapDayFebruary28, -2},
+	} {
+		assert.Equal(t, c.expected, c.d.MonthsBetween(c.e, c.policy), "%s - %s", c.d, c.e)
+	}
+}
+
+func Test_Date_startOf(t *testing.T) {
+	d := New(2026, August, 19) // Wednesday
+	assert.Equal(t, New(2026, August, 1), d.StartOfMonth())
+	assert.Equal(t, New(2026, August, 31), d.EndOfMonth())
+	assert.Equal(t, New(2024, February, 29), New(2024, February, 3).EndOfMonth())
+	assert.Equal(t, New(2026, February, 28), New(2026, February, 3).EndOfMonth())
+	assert.Equal(t, New(2026, April, 30), New(2026, April, 30).EndOfMonth())
+	assert.Equal(t, New(2026, August, 17), d.StartOfWeek(Monday))
+	assert.Equal(t, New(2026, August, 16), d.StartOfWeek(Sunday))
+	assert.Equal(t, New(2026, August, 15), d.StartOfWeek(Saturday))
+	assert.Equal(t, d, d.StartOfWeek(Wednesday))
+	assert.Equal(t, New(2026, August, 13), d.StartOfWeek(Thursday))
+	assert.Equal(t, New(2026, July, 1), d.StartOfQuarter())
+	assert.Equal(t, New(2026, January, 1), New(2026, March, 31).StartOfQuarter())
+	assert.Equal(t, New(2026, October, 1), New(2026, December, 31).StartOfQuarter())
+	assert.Equal(t, New(2026, January, 1), d.StartOfYear())
+	assert.Equal(t, New(-4, February, 29), New(-4, February, 1).EndOfMonth())
+}
+
+func Test_Date_DayNumber(t *testing.T) {
+	assert.Equal(t, int64(0), Date{}.DayNumber())
+	assert.Equal(t, Date{}, FromDayNumber(0))
+	assert.Equal(t, int64(719162), New(1970, January, 1).DayNumber())
+	assert.Equal(t, New(2026, October, 18), FromDayNumber(New(2026, October, 18).DayNumber()))
+	assert.Equal(t, int64(-1), New(0, December, 31).DayNumber())
+	assert.Equal(t, int64(784352296304), Date{year: math.MaxInt32, month: 11, day: 30}.DayNumber())
+	assert.Equal(t, Date{year: math.MinInt32}, FromDayNumber(Date{year: math.MinInt32}.DayNumber()))
+}
+
+func Test_Date_JulianDay(t *testing.T) {
+	assert.Equal(t, int64(1721426), Date{}.JulianDay())
+	assert.Equal(t, int64(2451545), New(2000, January, 1).JulianDay())
+	assert.Equal(t, int64(2461332), New(2026, October, 18).JulianDay())
+	assert.Equal(t, New(2000, January, 1), FromJulianDay(2451545))
+	assert.Equal(t, New(-4713, November, 24), FromJulianDay(0))
 }
 
 func Test_Date_AddDuration(t *testing.T) {
@@ -98,6 +288,8 @@ func Test_Date_After(t *testing.T) {
 	assert.True(t, New(2002, August, 7).After(New(2002, August, 5)))
 	assert.True(t, New(2002, August, 7).After(New(2000, August, 7)))
 	assert.True(t, New(2002, August, 7).After(New(2002, June, 7)))
+	assert.True(t, New(1, January, 1).After(New(0, December, 31)))
+	assert.True(t, Date{year: math.MaxInt32}.After(Date{year: math.MaxInt32 - 1, month: 11, day: 30}))
 }
 
 func Test_Date_Before(t *testing.T) {
@@ -114,6 +306,10 @@ func Test_Date_Sub(t *testing.T) {
 	assert.Equal(t, time.Duration(0), New(2002, August, 7).Sub(New(2002, August, 7)))
 	assert.Equal(t, time.Hour*24, New(2002, August, 7).Sub(New(2002, August, 6)))
 	assert.Equal(t, -time.Hour*24, New(2002, August, 7).Sub(New(2002, August, 8)))
+	assert.Equal(t, time.Duration(math.MaxInt64), New(2026, January, 1).Sub(New(1600, January, 1)))
+	assert.Equal(t, time.Duration(math.MinInt64), New(1600, January, 1).Sub(New(2026, January, 1)))
+	assert.Equal(t, New(2026, January, 1).Time().Sub(New(1800, January, 1).Time()), New(2026, January, 1).Sub(New(1800, January, 1)))
+	assert.Equal(t, time.Duration(math.MaxInt64), Date{year: math.MaxInt32}.Sub(Date{year: math.MinInt32}))
 }
 
 func Test_Date_DaysBetween(t *testing.T) {
@@ -122,6 +318,8 @@ func Test_Date_DaysBetween(t *testing.T) {
 	assert.Equal(t, -1, New(2002, August, 7).DaysBetween(New(2002, August, 8)))
 	// 2012-06-30 has overlap second
 	assert.Equal(t, -366, New(2012, January, 1).DaysBetween(New(2013, January, 1)))
+	assert.Equal(t, 3652059, New(10000, January, 1).DaysBetween(New(1, January, 1)))
+	assert.Equal(t, int64(-1568704592609), Date{year: math.MinInt32}.DayNumber()-Date{year: math.MaxInt32, month: 11, day: 30}.DayNumber())
 }
 
 func Test_Date_MarshalBinary(t *testing.T) {
@@ -156,16 +354,86 @@ func Test_Date_UnmarshalBinary(t *testing.T) {
 			Data:  []byte{1},
 		},
 		{ // 4
-			Error: test.Error("date.Date.UnmarshalBinary: unsupported version: expected 1 instead of 50"),
+			Error: test.Error("date.Date.UnmarshalBinary: unsupported version: expected 1 or 2 instead of 50"),
 			Data:  []byte(`2002`),
 		},
 		{ // 5
-			Error: test.Error("date.Date.UnmarshalBinary: unsupported version: expected 1 instead of 34"),
+			Error: test.Error("date.Date.UnmarshalBinary: unsupported version: expected 1 or 2 instead of 34"),
 			Data:  []byte(`"2002-08-07"`),
 		},
+		{ // 6
+			Data:  []byte{2, 0x84, 0xba, 0x01},
+			Value: New(2002, August, 7),
+		},
+		{ // 7
+			Data:  []byte{2, 0x01},
+			Value: New(1969, December, 31),
+		},
+		{ // 8
+			Data:  []byte{2, 0xec, 0x90, 0xfa, 0xef, 0xd3, 0x2d},
+			Value: Date{year: math.MaxInt32, month: 11, day: 30},
+		},
+		{ // 9
+			Error: test.Error("date.Date.UnmarshalBinary: invalid length: invalid varint"),
+			Data:  []byte{2},
+		},
+		{ // 10
+			Error: test.Error("date.Date.UnmarshalBinary: invalid length: invalid varint"),
+			Data:  []byte{2, 0x84, 0xba},
+		},
+		{ // 11
+			Error: test.Error("date.Date.UnmarshalBinary: invalid length: invalid varint"),
+			Data:  []byte{2, 0x84, 0xba, 0x01, 0x00},
+		},
+		{ // 12
+			Error: test.Error("date.Date.UnmarshalBinary: out of range: 784351577143 days"),
+			Data:  []byte{2, 0xee, 0x90, 0xfa, 0xef, 0xd3, 0x2d},
+		},
 	}, nil)
 }
 
+func Test_Date_MarshalBinary_version2(t *testing.T) {
+	defer func() {
+		BinaryVersion = BinaryVersion1
+	}()
+	BinaryVersion = BinaryVersion2
+	test.MarshalBinary(t, []test.CaseBinary[Date]{
+		{ // 0
+			Data:  []byte{2, 0xf3, 0xe4, 0x57},
+			Value: Date{},
+		},
+		{ // 1
+			Data:  []byte{2, 0x84, 0xba, 0x01},
+			Value: New(2002, August, 7),
+		},
+		{ // 2
+			Data:  []byte{2, 0x00},
+			Value: New(1970, January, 1),
+		},
+		{ // 3
+			Data:  []byte{2, 0xd5, 0xda, 0xa9, 0xf1, 0xd3, 0x2d},
+			Value: Date{year: math.MinInt32},
+		},
+	})
+	BinaryVersion = 3
+	test.MarshalBinary(t, []test.CaseBinary[Date]{
+		{
+			Error: test.Error("date.Date.MarshalBinary: unsupported version: 3"),
+			Value: Date{},
+		},
+	})
+}
+
+func Test_Date_unixDays(t *testing.T) {
+	for days := int64(-1000000); days < 1000000; days += 7 {
+		d := fromUnixDays(days)
+		require.Equal(t, FromTime(time.Date(1970, January, int(days)+1, 0, 0, 0, 0, time.UTC)), d, days)
+		require.Equal(t, days, d.unixDays())
+	}
+	assert.Equal(t, Date{year: math.MinInt32}, fromUnixDays(minUnixDays))
+	assert.Equal(t, Date{year: math.MaxInt32, month: 11, day: 30}, fromUnixDays(maxUnixDays))
+}
+
 func Test_Date_MarshalText(t *testing.T) {
 	test.MarshalText(t, []test.CaseText[Date]{
 		{ // 0
@@ -213,6 +481,23 @@ func Test_Date_UnmarshalText(t *testing.T) {
 	}, nil)
 }
 
+func Test_Date_negative(t *testing.T) {
+	d := New(-44, March, 15)
+	b, err := d.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, `-00044-03-15`, string(b))
+	v := Date{}
+	require.NoError(t, v.UnmarshalText(b))
+	assert.Equal(t, d, v)
+
+	b, err = json.Marshal(d)
+	require.NoError(t, err)
+	assert.Equal(t, `"-00044-03-15"`, string(b))
+	v = Date{}
+	require.NoError(t, json.Unmarshal(b, &v))
+	assert.Equal(t, d, v)
+}
+
 func Test_Date_xml(t *testing.T) {
 	b := []byte(`<test a="2020-08-05"><b>2020-08-07</b></test>`)
 	test := struct {
@@ -232,6 +517,11 @@ func Test_Date_Format(t *testing.T) {
 	assert.Equal(t, `20020807`, fmt.Sprintf("%b", New(2002, August, 7)))
 	assert.Equal(t, `2002-08-07`, fmt.Sprintf("%e", New(2002, August, 7)))
 	assert.Equal(t, `2002-08-07`, fmt.Sprintf("%s", New(2002, August, 7)))
+	assert.Equal(t, `20020807`, fmt.Sprintf("%#s", New(2002, August, 7)))
+	assert.Equal(t, `2026-W42-3`, fmt.Sprintf("%W", New(2026, October, 14)))
+	assert.Equal(t, `2026W423`, fmt.Sprintf("%#W", New(2026, October, 14)))
+	assert.Equal(t, `2026-291`, fmt.Sprintf("%O", New(2026, October, 18)))
+	assert.Equal(t, `2026291`, fmt.Sprintf("%#O", New(2026, October, 18)))
 }
 
 func Test_Date_Scan(t *testing.T) {
@@ -285,4 +575,12 @@ func Test_formatByVerb(t *testing.T) {
 	assert.Equal(t, Format(0), formatByVerb('s'))
 	assert.Equal(t, Format(0), formatByVerb('e'))
 	assert.Equal(t, FormatBasic, formatByVerb('b'))
+	assert.Equal(t, FormatWeek, formatByVerb('W'))
+	assert.Equal(t, FormatOrdinal, formatByVerb('O'))
+}
+
+func Test_isoWeekday(t *testing.T) {
+	assert.Equal(t, 1, isoWeekday(Monday))
+	assert.Equal(t, 6, isoWeekday(Saturday))
+	assert.Equal(t, 7, isoWeekday(Sunday))
 }
@@ -0,0 +1,327 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"go.lstv.dev/util/constraint"
+)
+
+var (
+	// DateTimeFormatter is used by DateTime.MarshalText and other DateTime converting functions.
+	DateTimeFormatter = DefaultDateTimeFormatter
+
+	// DateTimeParser is used by DateTime.UnmarshalText function.
+	DateTimeParser = DefaultDateTimeParser[[]byte]
+)
+
+// ZonePolicy allows configuring DateTime.In behavior for local date-time
+// which does not exist (gap, e.g. clocks are turned forward) or exists twice (overlap, e.g. clocks are turned back) in location.
+// Available policies are:
+//   ZoneCompatible
+//   ZoneEarlier
+//   ZoneLater
+//   ZoneReject
+type ZonePolicy int
+
+const (
+	// ZoneCompatible uses ZoneLater for gaps and ZoneEarlier for overlaps.
+	// It is the same behavior as RFC 5545 (iCalendar) specifies.
+	ZoneCompatible = ZonePolicy(iota)
+
+	// ZoneEarlier uses the earlier instant for overlaps,
+	// and for gaps instant with offset before the gap (i.e. local time is moved backward by gap length).
+	ZoneEarlier
+
+	// ZoneLater uses the later instant for overlaps,
+	// and for gaps instant with offset after the gap (i.e. local time is moved forward by gap length).
+	ZoneLater
+
+	// ZoneReject returns error wrapping ErrNonexistentTime for gaps and ErrAmbiguousTime for overlaps.
+	ZoneReject
+)
+
+// DateTime is representation of local date and time of day without time zone.
+// Zero value is valid, representing value 0001-01-01T00:00.
+type DateTime struct {
+	date  Date
+	clock Clock
+}
+
+// NewDateTime creates date-time from passed date and clock.
+func NewDateTime(d Date, c Clock) DateTime {
+	return DateTime{date: d, clock: c}
+}
+
+// DateTimeOf returns local date-time of passed time in its location.
+func DateTimeOf(t time.Time) DateTime {
+	return DateTime{date: FromTime(t), clock: ClockOf(t)}
+}
+
+// IsZero returns true if date-time is 0001-01-01T00:00.
+func (dt DateTime) IsZero() bool {
+	return dt.date.IsZero() && dt.clock.IsZero()
+}
+
+// Equal returns true if passed date-time is the same value.
+func (dt DateTime) Equal(o DateTime) bool {
+	return dt == o
+}
+
+// Date returns date part.
+func (dt DateTime) Date() Date {
+	return dt.date
+}
+
+// Clock returns time of day part.
+func (dt DateTime) Clock() Clock {
+	return dt.clock
+}
+
+// After returns true if current date-time is after passed one.
+func (dt DateTime) After(o DateTime) bool {
+	return dt.date.After(o.date) || (dt.date.Equal(o.date) && dt.clock.After(o.clock))
+}
+
+// Before returns true if current date-time is before passed one.
+func (dt DateTime) Before(o DateTime) bool {
+	return dt.date.Before(o.date) || (dt.date.Equal(o.date) && dt.clock.Before(o.clock))
+}
+
+// Add passed duration to date-time.
+// Every day has 24 hours, because date-time has no time zone.
+func (dt DateTime) Add(d time.Duration) DateTime {
+	return DateTimeOf(dt.Time().Add(d))
+}
+
+// AddDate passed years, months and days to date part, clock part is kept.
+func (dt DateTime) AddDate(years, months, days int) DateTime {
+	return DateTime{date: dt.date.Add(years, months, days), clock: dt.clock}
+}
+
+// Sub returns duration between passed date-time and current one.
+// Every day has 24 hours, because date-time has no time zone.
+func (dt DateTime) Sub(o DateTime) time.Duration {
+	return dt.Time().Sub(o.Time())
+}
+
+// Time returns time.Time with the same date and clock in time.UTC.
+func (dt DateTime) Time() time.Time {
+	return dt.date.Time().Add(time.Duration(dt.clock.ns))
+}
+
+// In resolves local date-time in passed location.
+// If date-time does not exist or exists twice in location (typically because of daylight saving time transition),
+// result depends on passed policy.
+// It can return wrapped ErrNonexistentTime or ErrAmbiguousTime if ZoneReject policy is used.
+func (dt DateTime) In(loc *time.Location, policy ZonePolicy) (time.Time, error) {
+	wall := dt.Time().Unix()
+	ns := int64(dt.clock.Nanosecond())
+	// offsets one day before and after cover any transition around local date-time
+	before := zoneOffset(wall-24*60*60, loc)
+	after := zoneOffset(wall+24*60*60, loc)
+	candidates := make([]int64, 0, 2)
+	for _, offset := range []int64{before, after} {
+		if u := wall - offset; zoneOffset(u, loc) == offset && (len(candidates) == 0 || candidates[0] != u) {
+			candidates = append(candidates, u)
+		}
+	}
+	u := int64(0)
+	switch {
+	case len(candidates) == 1:
+		u = candidates[0]
+	case len(candidates) == 2: // overlap
+		if policy == ZoneReject {
+			return time.Time{}, fmt.Errorf("date.DateTime.In: %w: %s in %s", ErrAmbiguousTime, dt, loc)
+		}
+		u = candidates[0]
+		if (candidates[1] < u) != (policy == ZoneLater) {
+			u = candidates[1]
+		}
+	default: // gap
+		switch policy {
+		case ZoneReject:
+			return time.Time{}, fmt.Errorf("date.DateTime.In: %w: %s in %s", ErrNonexistentTime, dt, loc)
+		case ZoneEarlier:
+			u = wall - after
+		default:
+			u = wall - before
+		}
+	}
+	return time.Unix(u, ns).In(loc), nil
+}
+
+// MarshalBinary converts date-time to binary representation.
+// It never returns error.
+//
+// Byte positions:
+//   0       1-4  5     6   7-14
+//   version year month day nanoseconds since midnight
+func (dt DateTime) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 15)
+	copy(b, dt.date.binaryV1())
+	binary.BigEndian.PutUint64(b[7:], uint64(dt.clock.ns))
+	return b, nil
+}
+
+// UnmarshalBinary sets date-time from passed data.
+// It can return wrapped ErrUnsupportedVersion, ErrInvalidLength or ErrInvalidClock.
+func (dt *DateTime) UnmarshalBinary(data []byte) error {
+	l := len(data)
+	if l == 0 {
+		return fmt.Errorf("date.DateTime.UnmarshalBinary: %w: empty data", ErrInvalidLength)
+	}
+	if data[0] != version {
+		return fmt.Errorf("date.DateTime.UnmarshalBinary: %w: expected %d instead of %d", ErrUnsupportedVersion, version, data[0])
+	}
+	if l != 15 { // version(1)+year(4)+month(1)+day(1)+nanoseconds(8)
+		return fmt.Errorf("date.DateTime.UnmarshalBinary: %w: expected 15 instead of %d", ErrInvalidLength, l)
+	}
+	v := DateTime{}
+	if err := v.date.UnmarshalBinary(data[:7]); err != nil {
+		return fmt.Errorf("date.DateTime.UnmarshalBinary: %w", err)
+	}
+	ns := int64(binary.BigEndian.Uint64(data[7:]))
+	if ns < 0 || ns >= nsPerDay {
+		return fmt.Errorf("date.DateTime.UnmarshalBinary: %w: %d", ErrInvalidClock, ns)
+	}
+	v.clock.ns = ns
+	*dt = v
+	return nil
+}
+
+// MarshalText converts date-time to text with DateTimeFormatter.
+func (dt DateTime) MarshalText() ([]byte, error) {
+	b, err := DateTimeFormatter(nil, dt, 0)
+	if err != nil {
+		return nil, fmt.Errorf("date.DateTime.MarshalText: %w", err)
+	}
+	return b, nil
+}
+
+// UnmarshalText using global DateTimeParser function.
+func (dt *DateTime) UnmarshalText(data []byte) error {
+	v, err := DateTimeParser(data, 0)
+	if err != nil {
+		return fmt.Errorf("date.DateTime.UnmarshalText: %w", err)
+	}
+	*dt = v
+	return nil
+}
+
+// Format is implementation for fmt.Formatter.
+// Flag # enforce basic format for any verb, e.g. %#S is "20060102T150405".
+//
+//   ┌ Verb ┬ Format ────────┬ Example ──────────────┐
+//   │ %b   │ FormatBasic    │ "20060102T1504"       │
+//   │ %s   │ Format(0)      │ "2006-01-02T15:04"    │
+//   │ %S   │ FormatSeconds  │ "2006-01-02T15:04:05" │
+func (dt DateTime) Format(f fmt.State, verb rune) {
+	format := Format(0)
+	switch verb {
+	case 'b':
+		format = FormatBasic
+	case 'S':
+		format = FormatSeconds
+	}
+	if f.Flag('#') {
+		format |= FormatBasic
+	}
+	f.Write(dt.format(format))
+}
+
+// Scan is support for database/sql package.
+// It accepts time.Time (its local date-time is used) and text (string or []byte) parsed by global DateTimeParser function.
+// It can return wrapped ErrInvalidType or parser error.
+func (dt *DateTime) Scan(src any) error {
+	b := []byte(nil)
+	switch v := src.(type) {
+	case time.Time:
+		*dt = DateTimeOf(v)
+		return nil
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return fmt.Errorf("date.DateTime.Scan: %w: expected time.Time, string or []byte instead of %T", ErrInvalidType, src)
+	}
+	v, err := DateTimeParser(b, 0)
+	if err != nil {
+		return fmt.Errorf("date.DateTime.Scan: %w", err)
+	}
+	*dt = v
+	return nil
+}
+
+// Value is support for database/sql package.
+// It returns time.Time in time.UTC or text with FormatSeconds depending on SQLValueForm.
+func (dt DateTime) Value() (driver.Value, error) {
+	if SQLValueForm == ValueFormText {
+		b, err := DateTimeFormatter(nil, dt, FormatSeconds)
+		if err != nil {
+			return nil, fmt.Errorf("date.DateTime.Value: %w", err)
+		}
+		return string(b), nil
+	}
+	return dt.Time(), nil
+}
+
+// String formats date-time for string output.
+// If DateTimeFormatter returns error, String returns same value as DefaultDateTimeFormatter.
+func (dt DateTime) String() string {
+	return string(dt.format(0))
+}
+
+func (dt DateTime) format(f Format) []byte {
+	b, err := DateTimeFormatter(nil, dt, f)
+	if err != nil {
+		b, _ = DefaultDateTimeFormatter(nil, dt, f)
+	}
+	return b
+}
+
+// DefaultDateTimeFormatter formats date-time as date formatted by Formatter and clock formatted by ClockFormatter
+// separated by "T", e.g. 2006-01-02T15:04.
+// Format flags are passed to both formatters.
+// If Formatter or ClockFormatter returns error, the same values as DefaultFormatter and DefaultClockFormatter are used.
+func DefaultDateTimeFormatter(buf []byte, dt DateTime, f Format) ([]byte, error) {
+	buf = append(buf, dt.date.format(f)...)
+	buf = append(buf, 'T')
+	return append(buf, dt.clock.format(f)...), nil
+}
+
+// DefaultDateTimeParser parse DateTime from input.
+// Date and clock must be separated by "T" (or space) and they are parsed by global Parser and ClockParser functions.
+// Time zone designators are not accepted.
+// Rules are passed to both parsers.
+func DefaultDateTimeParser[T constraint.ParserInput](input T, r Rule) (DateTime, error) {
+	const funcName = "DefaultDateTimeParser"
+	b := []byte(input)
+	i := bytes.IndexAny(b, "Tt ")
+	if i < 0 {
+		return DateTime{}, newParseError(funcName, input, nil)
+	}
+	d, err := Parser(b[:i], r)
+	if err != nil {
+		return DateTime{}, newParseError(funcName, input, err)
+	}
+	c, err := ClockParser(b[i+1:], r)
+	if err != nil {
+		return DateTime{}, newParseError(funcName, input, err)
+	}
+	return DateTime{date: d, clock: c}, nil
+}
+
+// zoneOffset returns offset in seconds of passed Unix time in location.
+func zoneOffset(unix int64, loc *time.Location) int64 {
+	_, offset := time.Unix(unix, 0).In(loc).Zone()
+	return int64(offset)
+}
@@ -0,0 +1,220 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.lstv.dev/util/test"
+)
+
+func Test_NewDateTime(t *testing.T) {
+	dt := NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 0))
+	assert.Equal(t, New(2026, October, 18), dt.Date())
+	assert.Equal(t, NewClock(20, 45, 0, 0), dt.Clock())
+	assert.True(t, DateTime{}.IsZero())
+	assert.False(t, dt.IsZero())
+	assert.Equal(t, dt, DateTimeOf(time.Date(2026, October, 18, 20, 45, 0, 0, time.FixedZone("+2", 2*60*60))))
+	assert.Equal(t, time.Date(2026, October, 18, 20, 45, 0, 0, time.UTC), dt.Time())
+}
+
+func Test_DateTime_arithmetic(t *testing.T) {
+	dt := NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 0))
+	assert.Equal(t, NewDateTime(New(2026, October, 19), NewClock(0, 15, 0, 0)), dt.Add(3*time.Hour+30*time.Minute))
+	assert.Equal(t, NewDateTime(New(2026, November, 19), NewClock(20, 45, 0, 0)), dt.AddDate(0, 1, 1))
+	assert.Equal(t, 25*time.Hour, dt.Add(25*time.Hour).Sub(dt))
+
+	later := dt.Add(time.Nanosecond)
+	assert.True(t, dt.Before(later))
+	assert.False(t, later.Before(dt))
+	assert.True(t, later.After(dt))
+	assert.False(t, dt.After(dt))
+	assert.True(t, dt.AddDate(0, 0, -1).Before(dt.Add(-time.Hour)))
+	assert.True(t, dt.Equal(NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 0))))
+}
+
+func Test_DateTime_In(t *testing.T) {
+	prague, err := time.LoadLocation("Europe/Prague")
+	require.NoError(t, err)
+	at := func(d Date, hour, min int) DateTime {
+		return NewDateTime(d, NewClock(hour, min, 0, 0))
+	}
+	utc := func(d Date, hour, min int) time.Time {
+		return at(d, hour, min).Time()
+	}
+	spring, autumn := New(2026, March, 29), New(2026, October, 25)
+
+	for _, c := range []struct {
+		dt       DateTime
+		policy   ZonePolicy
+		expected time.Time
+		err      error
+	}{
+		// regular times
+		{at(spring, 12, 0), ZoneReject, utc(spring, 10, 0), nil},
+		{at(autumn, 12, 0), ZoneReject, utc(autumn, 11, 0), nil},
+		// gap 02:00-03:00
+		{at(spring, 2, 30), ZoneCompatible, utc(spring, 1, 30), nil},
+		{at(spring, 2, 30), ZoneLater, utc(spring, 1, 30), nil},
+		{at(spring, 2, 30), ZoneEarlier, utc(spring, 0, 30), nil},
+		{at(spring, 2, 30), ZoneReject, time.Time{}, ErrNonexistentTime},
+		// overlap 02:00-03:00
+		{at(autumn, 2, 30), ZoneCompatible, utc(autumn, 0, 30), nil},
+		{at(autumn, 2, 30), ZoneEarlier, utc(autumn, 0, 30), nil},
+		{at(autumn, 2, 30), ZoneLater, utc(autumn, 1, 30), nil},
+		{at(autumn, 2, 30), ZoneReject, time.Time{}, ErrAmbiguousTime},
+	} {
+		v, err := c.dt.In(prague, c.policy)
+		if c.err != nil {
+			assert.True(t, errors.Is(err, c.err), c.dt)
+			assert.Zero(t, v)
+			continue
+		}
+		require.NoError(t, err, c.dt)
+		assert.True(t, c.expected.Equal(v), "%s: %s != %s", c.dt, c.expected, v)
+		assert.Equal(t, prague, v.Location())
+	}
+
+	_, err = at(spring, 2, 30).In(prague, ZoneReject)
+	assert.EqualError(t, err, "date.DateTime.In: nonexistent time: 2026-03-29T02:30 in Europe/Prague")
+
+	v, err := NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 5)).In(time.UTC, ZoneReject)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, October, 18, 20, 45, 0, 5, time.UTC), v)
+}
+
+func Test_DateTime_MarshalBinary(t *testing.T) {
+	test.MarshalBinary(t, []test.CaseBinary[DateTime]{
+		{ // 0
+			Data:  []byte{1, 0, 0, 0, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0},
+			Value: DateTime{},
+		},
+		{ // 1
+			Data:  []byte{1, 0, 0, 7, 234, 10, 18, 0, 0, 0x43, 0xf0, 0x72, 0xf6, 0xf8, 0x00},
+			Value: NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 0)),
+		},
+	})
+}
+
+func Test_DateTime_UnmarshalBinary(t *testing.T) {
+	test.UnmarshalBinary(t, []test.CaseBinary[DateTime]{
+		{ // 0
+			Data:  []byte{1, 0, 0, 7, 234, 10, 18, 0, 0, 0x43, 0xf0, 0x72, 0xf6, 0xf8, 0x00},
+			Value: NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 0)),
+		},
+		{ // 1
+			Error: test.Error("date.DateTime.UnmarshalBinary: invalid length: empty data"),
+			Data:  []byte{},
+		},
+		{ // 2
+			Error: test.Error("date.DateTime.UnmarshalBinary: unsupported version: expected 1 instead of 2"),
+			Data:  []byte{2},
+		},
+		{ // 3
+			Error: test.Error("date.DateTime.UnmarshalBinary: invalid length: expected 15 instead of 7"),
+			Data:  []byte{1, 0, 0, 7, 234, 10, 18},
+		},
+		{ // 4
+			Error: test.Error("date.DateTime.UnmarshalBinary: invalid clock: 86400000000000"),
+			Data:  []byte{1, 0, 0, 7, 234, 10, 18, 0, 0, 0x4e, 0x94, 0x91, 0x4f, 0x00, 0x00},
+		},
+	}, nil)
+}
+
+func Test_DateTime_MarshalText(t *testing.T) {
+	Formatter = DefaultFormatter
+	ClockFormatter = DefaultClockFormatter
+	test.MarshalText(t, []test.CaseText[DateTime]{
+		{ // 0
+			Data:  `0001-01-01T00:00`,
+			Value: DateTime{},
+		},
+		{ // 1
+			Data:  `2026-10-18T20:45:30.5`,
+			Value: NewDateTime(New(2026, October, 18), NewClock(20, 45, 30, 500000000)),
+		},
+	})
+	DateTimeFormatter = func(buf []byte, dt DateTime, f Format) ([]byte, error) {
+		return nil, errors.New("error")
+	}
+	test.MarshalText(t, []test.CaseText[DateTime]{
+		{
+			Error: test.Error("date.DateTime.MarshalText: error"),
+			Value: DateTime{},
+		},
+	})
+	assert.Equal(t, "0001-01-01T00:00", DateTime{}.String())
+	DateTimeFormatter = DefaultDateTimeFormatter
+}
+
+func Test_DateTime_UnmarshalText(t *testing.T) {
+	test.UnmarshalText(t, []test.CaseText[DateTime]{
+		{ // 0
+			Data:  `2026-10-18T20:45`,
+			Value: NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 0)),
+		},
+		{ // 1
+			Data:  `2026-10-18 20:45:30`,
+			Value: NewDateTime(New(2026, October, 18), NewClock(20, 45, 30, 0)),
+		},
+		{ // 2
+			Data:  `20261018T2045`,
+			Value: NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 0)),
+		},
+		{ // 3
+			Error: test.Error(`date.DateTime.UnmarshalText: date.DefaultDateTimeParser: "2026-10-18": invalid date`),
+			Data:  `2026-10-18`,
+		},
+		{ // 4
+			Error: test.Error(`date.DateTime.UnmarshalText: date.DefaultDateTimeParser: "2026-10-32T20:45": date.DefaultParser: "2026-10-32": invalid date`),
+			Data:  `2026-10-32T20:45`,
+		},
+		{ // 5
+			Error: test.Error(`date.DateTime.UnmarshalText: date.DefaultDateTimeParser: "2026-10-18T20:45Z": date.DefaultClockParser: "20:45Z": invalid clock`),
+			Data:  `2026-10-18T20:45Z`,
+		},
+	}, nil)
+}
+
+func Test_DateTime_Format(t *testing.T) {
+	dt := NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 0))
+	assert.Equal(t, "2026-10-18T20:45", fmt.Sprintf("%s", dt))
+	assert.Equal(t, "20261018T2045", fmt.Sprintf("%b", dt))
+	assert.Equal(t, "2026-10-18T20:45:00", fmt.Sprintf("%S", dt))
+	assert.Equal(t, "20261018T204500", fmt.Sprintf("%#S", dt))
+}
+
+func Test_DateTime_Scan(t *testing.T) {
+	dt := DateTime{}
+	require.NoError(t, dt.Scan(time.Date(2026, October, 18, 20, 45, 0, 0, time.FixedZone("+2", 2*60*60))))
+	assert.Equal(t, NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 0)), dt)
+	require.NoError(t, dt.Scan("2026-10-19 21:00:00"))
+	assert.Equal(t, NewDateTime(New(2026, October, 19), NewClock(21, 0, 0, 0)), dt)
+	require.NoError(t, dt.Scan([]byte("2026-10-20T22:00")))
+	assert.Equal(t, NewDateTime(New(2026, October, 20), NewClock(22, 0, 0, 0)), dt)
+	assert.EqualError(t, dt.Scan("x"), `date.DateTime.Scan: date.DefaultDateTimeParser: "x": invalid date`)
+	assert.EqualError(t, dt.Scan(1), "date.DateTime.Scan: invalid type: expected time.Time, string or []byte instead of int")
+}
+
+func Test_DateTime_Value(t *testing.T) {
+	defer func() {
+		SQLValueForm = ValueFormTime
+	}()
+	dt := NewDateTime(New(2026, October, 18), NewClock(20, 45, 0, 0))
+	v, err := dt.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, October, 18, 20, 45, 0, 0, time.UTC), v)
+	SQLValueForm = ValueFormText
+	v, err = dt.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-10-18T20:45:00", v)
+}
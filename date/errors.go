@@ -20,11 +20,17 @@ var (
 	// Use errors.Is to check if returned error is ErrInvalidLength.
 	ErrInvalidLength = errors.New("invalid length")
 
-	// ErrUnsupportedVersion is wrapped and returned by Date.UnmarshalBinary if passed input has unsupported version.
+	// ErrUnsupportedVersion is wrapped and returned by Date.UnmarshalBinary if passed input has unsupported version
+	// and by Date.MarshalBinary if BinaryVersion is not supported.
 	// Use errors.Is to check if returned error is ErrUnsupportedVersion.
 	ErrUnsupportedVersion = errors.New("unsupported version")
 
-	// ErrInvalidType is wrapped and returned by Date.Scan if passed type is invalid.
+	// ErrOutOfRange is wrapped and returned by Date.UnmarshalBinary and Date.Scan if passed input is out of range of Date
+	// and by Year.MarshalBinary and Year.Scan if year does not fit into int32.
+	// Use errors.Is to check if returned error is ErrOutOfRange.
+	ErrOutOfRange = errors.New("out of range")
+
+	// ErrInvalidType is wrapped and returned by Date.Scan, FormatFilter and Set.UnmarshalJSON if passed type is invalid or disabled.
 	// Use errors.Is to check if returned error is ErrInvalidType.
 	ErrInvalidType = errors.New("invalid type")
 
@@ -32,9 +38,66 @@ var (
 	// Use errors.Is to check if returned error is ErrBasicFormatDisabled.
 	ErrBasicFormatDisabled = errors.New("basic format disabled")
 
-	// ErrInvalidFromOrTo is wrapped and returned by FilterFromTo if passed from or to is invalid.
+	// ErrWeekFormatDisabled is wrapped and returned by DefaultParser if RuleDisableWeek is present and input is week date.
+	// Use errors.Is to check if returned error is ErrWeekFormatDisabled.
+	ErrWeekFormatDisabled = errors.New("week format disabled")
+
+	// ErrOrdinalFormatDisabled is wrapped and returned by DefaultParser if RuleDisableOrdinal is present and input is ordinal date.
+	// Use errors.Is to check if returned error is ErrOrdinalFormatDisabled.
+	ErrOrdinalFormatDisabled = errors.New("ordinal format disabled")
+
+	// ErrExpandedFormatDisabled is wrapped and returned by DefaultParser if RuleEnableExpanded is not present and input has year with plus sign.
+	// Use errors.Is to check if returned error is ErrExpandedFormatDisabled.
+	ErrExpandedFormatDisabled = errors.New("expanded format disabled")
+
+	// ErrObjectFormDisabled is wrapped and returned by Date.UnmarshalJSON if RuleEnableJSONObjectForm is not present and input is JSON object.
+	// Use errors.Is to check if returned error is ErrObjectFormDisabled.
+	ErrObjectFormDisabled = errors.New("object form disabled")
+
+	// ErrUnixFormDisabled is wrapped and returned by Date.UnmarshalJSON if neither RuleEnableJSONUnixForm nor RuleEnableJSONUnixMilliForm is present and input is JSON number.
+	// Use errors.Is to check if returned error is ErrUnixFormDisabled.
+	ErrUnixFormDisabled = errors.New("unix form disabled")
+
+	// ErrInvalidObject is wrapped and returned by Date.UnmarshalJSON if JSON object form has missing or unknown keys or invalid date.
+	// Use errors.Is to check if returned error is ErrInvalidObject.
+	ErrInvalidObject = errors.New("invalid object")
+
+	// ErrInvalidFromOrTo is wrapped and returned by FilterFromTo, ParseFilter and Range functions if passed from or to is invalid.
 	// Use errors.Is to check if returned error is ErrInvalidFromOrTo.
 	ErrInvalidFromOrTo = errors.New("invalid from or to")
+
+	// ErrInvalidLayout is wrapped and returned by FormatLayout, ParseLayout and functions returned by LayoutFormatter and LayoutParser if layout is invalid.
+	// Use errors.Is to check if returned error is ErrInvalidLayout.
+	ErrInvalidLayout = errors.New("invalid layout")
+
+	// ErrInvalidClock is wrapped and returned by DefaultClockParser and Clock.UnmarshalBinary if passed input is not valid time of day.
+	// Use errors.Is to check if returned error is ErrInvalidClock.
+	ErrInvalidClock = errors.New("invalid clock")
+
+	// ErrDayOutOfMonth is wrapped and returned by Date.AddMonths and Date.AddYears with MonthReject if day does not exist in resulting month.
+	// Use errors.Is to check if returned error is ErrDayOutOfMonth.
+	ErrDayOutOfMonth = errors.New("day out of month")
+
+	// ErrInvalidPeriod is wrapped and returned by ParsePeriod and Period.UnmarshalText if passed input is not valid period.
+	// Use errors.Is to check if returned error is ErrInvalidPeriod.
+	ErrInvalidPeriod = errors.New("invalid period")
+
+	// ErrNonexistentTime is wrapped and returned by DateTime.In with ZoneReject if local date-time is skipped in location (e.g. DST gap).
+	// Use errors.Is to check if returned error is ErrNonexistentTime.
+	ErrNonexistentTime = errors.New("nonexistent time")
+
+	// ErrAmbiguousTime is wrapped and returned by DateTime.In with ZoneReject if local date-time is repeated in location (e.g. DST overlap).
+	// Use errors.Is to check if returned error is ErrAmbiguousTime.
+	ErrAmbiguousTime = errors.New("ambiguous time")
+
+	// ErrInvalidRecurrence is wrapped and returned by ParseRecurrence and Recurrence.MarshalText if recurrence rule is invalid.
+	// Use errors.Is to check if returned error is ErrInvalidRecurrence.
+	ErrInvalidRecurrence = errors.New("invalid recurrence rule")
+
+	// ErrNoBusinessDay is wrapped and returned by Calendar.NextBusinessDay, Calendar.PreviousBusinessDay
+	// and Calendar.AddBusinessDays if no business day is found within 10 years.
+	// Use errors.Is to check if returned error is ErrNoBusinessDay.
+	ErrNoBusinessDay = errors.New("no business day")
 )
 
 // ParseError represents error during date parsing.
@@ -6,14 +6,145 @@ package date
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+
+	"go.lstv.dev/util/constraint"
 )
 
 // Filter represents date filter.
+//
+// Only range filters (created by Any, FilterFromTo and Range.Filter) have text form
+// and implement encoding.TextMarshaler and json.Marshaler, see FormatFilter.
+// Filters created by And, Or, Not, FilterWeekdays, FilterMonths, FilterMonthDays and FilterDates have no text form.
 type Filter interface {
 	// Contains returns true if passed date is accepted by filter.
 	Contains(date Date) bool
 }
 
+// Describer is implemented by filters which can describe themselves in words.
+// All filters of this package implement Describer.
+type Describer interface {
+	// Describe returns human-readable description of filter, e.g. "in July and not on 2026-07-04".
+	Describe() string
+}
+
+// Describe returns human-readable description of passed filter, usable for logs.
+// If filter implements neither Describer nor fmt.Stringer, its type is used.
+func Describe(f Filter) string {
+	switch v := f.(type) {
+	case Describer:
+		return v.Describe()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprintf("filter %T", f)
+	}
+}
+
+// ParseFilter parses filter from text form.
+// It returns the same filter as FilterFromTo does.
+// See ParseRange for accepted forms.
+// It can return *ParseError wrapping ErrInvalidFromOrTo.
+func ParseFilter[T constraint.ParserInput](input T) (Filter, error) {
+	r, err := parseRange("ParseFilter", input)
+	if err != nil {
+		return nil, err
+	}
+	return r.Filter(), nil
+}
+
+// FormatFilter appends text form of range filter to passed buffer, see Range.MarshalText for the text form.
+// Only range filters (created by Any, FilterFromTo and Range.Filter), Range, YearMonth and Year are supported.
+// It returns wrapped ErrInvalidType for all other filters, i.e. filters created by And, Or, Not,
+// FilterWeekdays, FilterMonths, FilterMonthDays and FilterDates.
+// It can also return error returned by Formatter.
+func FormatFilter(buf []byte, f Filter) ([]byte, error) {
+	r, ok := RangeFromFilter(f)
+	if !ok {
+		return nil, fmt.Errorf("date.FormatFilter: %w: expected range filter instead of %T", ErrInvalidType, f)
+	}
+	b, err := r.appendText(buf)
+	if err != nil {
+		return nil, fmt.Errorf("date.FormatFilter: %w", err)
+	}
+	return b, nil
+}
+
+// Any creates filter accepting all dates.
+func Any() Filter {
+	return filterNo{}
+}
+
+// And creates filter accepting dates accepted by all passed filters.
+// If no filter is passed, returned filter accepts all dates.
+func And(filters ...Filter) Filter {
+	f := make([]Filter, len(filters))
+	copy(f, filters)
+	return filterAnd(f)
+}
+
+// Or creates filter accepting dates accepted by at least one of passed filters.
+// If no filter is passed, returned filter accepts no date.
+func Or(filters ...Filter) Filter {
+	f := make([]Filter, len(filters))
+	copy(f, filters)
+	return filterOr(f)
+}
+
+// Not creates filter accepting dates not accepted by passed filter.
+func Not(f Filter) Filter {
+	return &filterNot{filter: f}
+}
+
+// FilterWeekdays creates filter accepting dates on passed weekdays.
+// Invalid weekdays are ignored.
+func FilterWeekdays(weekdays ...Weekday) Filter {
+	f := filterWeekdays(0)
+	for _, w := range weekdays {
+		if w >= Sunday && w <= Saturday {
+			f |= 1 << w
+		}
+	}
+	return f
+}
+
+// FilterMonths creates filter accepting dates in passed months.
+// Invalid months are ignored.
+func FilterMonths(months ...Month) Filter {
+	f := filterMonths(0)
+	for _, m := range months {
+		if m >= January && m <= December {
+			f |= 1 << m
+		}
+	}
+	return f
+}
+
+// FilterMonthDays creates filter accepting dates with passed days of month.
+// Negative day counts from the end of month, i.e. -1 is the last day of month.
+// Days out of ranges 1 to 31 and -31 to -1 are ignored.
+func FilterMonthDays(days ...int) Filter {
+	f := &filterMonthDays{}
+	for _, d := range days {
+		switch {
+		case d >= 1 && d <= 31:
+			f.days |= 1 << d
+		case d <= -1 && d >= -31:
+			f.fromEnd |= 1 << -d
+		}
+	}
+	return f
+}
+
+// FilterDates creates filter accepting only passed dates.
+func FilterDates(dates ...Date) Filter {
+	f := make([]Date, len(dates))
+	copy(f, dates)
+	Sort(f)
+	return filterDates(Dedupe(f))
+}
+
 // FilterFromTo creates new date filter based on from date and to date.
 // If from date (or to date) is nil, there isn't from date (or to date) limit.
 // From date (or to date) is including to filter.
@@ -52,6 +183,18 @@ func (filterNo) Contains(_ Date) bool {
 	return true
 }
 
+func (filterNo) Describe() string {
+	return "any date"
+}
+
+func (d filterNo) MarshalText() ([]byte, error) {
+	return marshalFilterText(d)
+}
+
+func (d filterNo) MarshalJSON() ([]byte, error) {
+	return marshalFilterJSON(d)
+}
+
 type filterDate struct {
 	date Date
 }
@@ -60,6 +203,18 @@ func (d *filterDate) Contains(date Date) bool {
 	return d.date.Equal(date)
 }
 
+func (d *filterDate) Describe() string {
+	return "on " + d.date.String()
+}
+
+func (d *filterDate) MarshalText() ([]byte, error) {
+	return marshalFilterText(d)
+}
+
+func (d *filterDate) MarshalJSON() ([]byte, error) {
+	return marshalFilterJSON(d)
+}
+
 type filterFrom struct {
 	from Date
 }
@@ -68,6 +223,18 @@ func (d *filterFrom) Contains(date Date) bool {
 	return d.from.Equal(date) || d.from.Before(date)
 }
 
+func (d *filterFrom) Describe() string {
+	return "from " + d.from.String()
+}
+
+func (d *filterFrom) MarshalText() ([]byte, error) {
+	return marshalFilterText(d)
+}
+
+func (d *filterFrom) MarshalJSON() ([]byte, error) {
+	return marshalFilterJSON(d)
+}
+
 type filterTo struct {
 	to Date
 }
@@ -76,6 +243,18 @@ func (d *filterTo) Contains(date Date) bool {
 	return d.to.Equal(date) || d.to.After(date)
 }
 
+func (d *filterTo) Describe() string {
+	return "to " + d.to.String()
+}
+
+func (d *filterTo) MarshalText() ([]byte, error) {
+	return marshalFilterText(d)
+}
+
+func (d *filterTo) MarshalJSON() ([]byte, error) {
+	return marshalFilterJSON(d)
+}
+
 type filterFromTo struct {
 	from Date
 	to   Date
@@ -84,3 +263,211 @@ type filterFromTo struct {
 func (d *filterFromTo) Contains(date Date) bool {
 	return d.from.Equal(date) || d.to.Equal(date) || (d.from.Before(date) && d.to.After(date))
 }
+
+func (d *filterFromTo) Describe() string {
+	return "from " + d.from.String() + " to " + d.to.String()
+}
+
+func (d *filterFromTo) MarshalText() ([]byte, error) {
+	return marshalFilterText(d)
+}
+
+func (d *filterFromTo) MarshalJSON() ([]byte, error) {
+	return marshalFilterJSON(d)
+}
+
+type filterAnd []Filter
+
+func (f filterAnd) Contains(date Date) bool {
+	for _, filter := range f {
+		if !filter.Contains(date) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f filterAnd) Describe() string {
+	if len(f) == 0 {
+		return filterNo{}.Describe()
+	}
+	return describeJoin(f, " and ")
+}
+
+type filterOr []Filter
+
+func (f filterOr) Contains(date Date) bool {
+	for _, filter := range f {
+		if filter.Contains(date) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f filterOr) Describe() string {
+	if len(f) == 0 {
+		return "no date"
+	}
+	return describeJoin(f, " or ")
+}
+
+type filterNot struct {
+	filter Filter
+}
+
+func (f *filterNot) Contains(date Date) bool {
+	return !f.filter.Contains(date)
+}
+
+func (f *filterNot) Describe() string {
+	return "not " + describeNested(f.filter)
+}
+
+// filterWeekdays is bitmask of accepted weekdays.
+type filterWeekdays uint8
+
+func (f filterWeekdays) Contains(date Date) bool {
+	return f&(1<<date.Weekday()) != 0
+}
+
+func (f filterWeekdays) Describe() string {
+	names := []string(nil)
+	for w := Sunday; w <= Saturday; w++ {
+		if f&(1<<w) != 0 {
+			names = append(names, w.String())
+		}
+	}
+	return describeWords("on ", names, "")
+}
+
+// filterMonths is bitmask of accepted months.
+type filterMonths uint16
+
+func (f filterMonths) Contains(date Date) bool {
+	return f&(1<<date.Month()) != 0
+}
+
+func (f filterMonths) Describe() string {
+	names := []string(nil)
+	for m := January; m <= December; m++ {
+		if f&(1<<m) != 0 {
+			names = append(names, m.String())
+		}
+	}
+	return describeWords("in ", names, "")
+}
+
+// filterMonthDays keeps bitmasks of accepted days from start and from end of month.
+type filterMonthDays struct {
+	days    uint32
+	fromEnd uint32
+}
+
+func (f *filterMonthDays) Contains(date Date) bool {
+	day := date.Day()
+	if f.days&(1<<day) != 0 {
+		return true
+	}
+	last := monthDays(date.Year(), date.Month())
+	return f.fromEnd&(1<<(last-day+1)) != 0
+}
+
+func (f *filterMonthDays) Describe() string {
+	names := []string(nil)
+	for d := 1; d <= 31; d++ {
+		if f.days&(1<<d) != 0 {
+			names = append(names, ordinal(d))
+		}
+	}
+	for d := 31; d >= 1; d-- {
+		if f.fromEnd&(1<<d) != 0 {
+			if d == 1 {
+				names = append(names, "last")
+			} else {
+				names = append(names, ordinal(d)+" last")
+			}
+		}
+	}
+	return describeWords("on ", names, " day of month")
+}
+
+// filterDates is sorted list of unique accepted dates.
+type filterDates []Date
+
+func (f filterDates) Contains(date Date) bool {
+	_, ok := BinarySearch(f, date)
+	return ok
+}
+
+func (f filterDates) Describe() string {
+	names := make([]string, len(f))
+	for i, d := range f {
+		names[i] = d.String()
+	}
+	return describeWords("on ", names, "")
+}
+
+func marshalFilterText(f Filter) ([]byte, error) {
+	r, _ := RangeFromFilter(f)
+	return r.MarshalText()
+}
+
+func marshalFilterJSON(f Filter) ([]byte, error) {
+	r, _ := RangeFromFilter(f)
+	return r.MarshalJSON()
+}
+
+// describeJoin describes filters and joins them by separator.
+func describeJoin(filters []Filter, sep string) string {
+	parts := make([]string, len(filters))
+	for i, f := range filters {
+		parts[i] = describeNested(f)
+	}
+	return strings.Join(parts, sep)
+}
+
+// describeNested describes filter and encloses description of compound filters in parentheses.
+func describeNested(f Filter) string {
+	switch v := f.(type) {
+	case filterAnd:
+		if len(v) > 1 {
+			return "(" + v.Describe() + ")"
+		}
+	case filterOr:
+		if len(v) > 1 {
+			return "(" + v.Describe() + ")"
+		}
+	}
+	return Describe(f)
+}
+
+// describeWords joins words in English way, i.e. "a, b or c", and adds prefix and suffix.
+// If there are no words, filter accepts no date.
+func describeWords(prefix string, words []string, suffix string) string {
+	switch l := len(words); l {
+	case 0:
+		return filterOr{}.Describe()
+	case 1:
+		return prefix + words[0] + suffix
+	default:
+		return prefix + strings.Join(words[:l-1], ", ") + " or " + words[l-1] + suffix
+	}
+}
+
+// ordinal returns English ordinal number, e.g. "1st" or "22nd".
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return strconv.Itoa(n) + suffix
+}
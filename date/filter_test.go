@@ -5,6 +5,9 @@
 package date
 
 import (
+	"encoding"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -86,3 +89,244 @@ func ExampleFilterFromTo() {
 	// 2020-08-07 true
 	// 2020-08-08 false
 }
+
+type customFilter struct{}
+
+func (customFilter) Contains(_ Date) bool {
+	return true
+}
+
+type stringerFilter struct{}
+
+func (stringerFilter) Contains(_ Date) bool {
+	return true
+}
+
+func (stringerFilter) String() string {
+	return "stringer"
+}
+
+func Test_Describe(t *testing.T) {
+	Formatter = DefaultFormatter
+	from, to := New(2026, July, 1), New(2026, July, 31)
+	f, _ := FilterFromTo(nil, nil)
+	assert.Equal(t, "any date", Describe(f))
+	f, _ = FilterFromTo(&from, &from)
+	assert.Equal(t, "on 2026-07-01", Describe(f))
+	f, _ = FilterFromTo(&from, nil)
+	assert.Equal(t, "from 2026-07-01", Describe(f))
+	f, _ = FilterFromTo(nil, &to)
+	assert.Equal(t, "to 2026-07-31", Describe(f))
+	f, _ = FilterFromTo(&from, &to)
+	assert.Equal(t, "from 2026-07-01 to 2026-07-31", Describe(f))
+	assert.Equal(t, "from 2026-07-01", RangeFrom(from).Describe())
+	assert.Equal(t, "filter date.customFilter", Describe(customFilter{}))
+	assert.Equal(t, "stringer", Describe(stringerFilter{}))
+}
+
+func Test_Any(t *testing.T) {
+	assert.True(t, Any().Contains(Date{}))
+	assert.Equal(t, "any date", Describe(Any()))
+}
+
+func Test_And(t *testing.T) {
+	f := And(FilterMonths(July), FilterWeekdays(Saturday, Sunday))
+	assert.True(t, f.Contains(New(2026, July, 4)))
+	assert.False(t, f.Contains(New(2026, July, 3)))
+	assert.False(t, f.Contains(New(2026, August, 1)))
+	assert.Equal(t, "in July and on Sunday or Saturday", Describe(f))
+	assert.True(t, And().Contains(Date{}))
+	assert.Equal(t, "any date", Describe(And()))
+
+	filters := []Filter{FilterMonths(July)}
+	f = And(filters...)
+	filters[0] = FilterMonths(August)
+	assert.True(t, f.Contains(New(2026, July, 3)))
+}
+
+func Test_Or(t *testing.T) {
+	f := Or(FilterMonths(July), FilterWeekdays(Saturday))
+	assert.True(t, f.Contains(New(2026, July, 3)))
+	assert.True(t, f.Contains(New(2026, August, 1)))
+	assert.False(t, f.Contains(New(2026, August, 3)))
+	assert.Equal(t, "in July or on Saturday", Describe(f))
+	assert.False(t, Or().Contains(Date{}))
+	assert.Equal(t, "no date", Describe(Or()))
+
+	filters := []Filter{FilterMonths(July)}
+	f = Or(filters...)
+	filters[0] = FilterMonths(August)
+	assert.True(t, f.Contains(New(2026, July, 3)))
+}
+
+func Test_Not(t *testing.T) {
+	f := Not(FilterMonths(July))
+	assert.False(t, f.Contains(New(2026, July, 3)))
+	assert.True(t, f.Contains(New(2026, August, 1)))
+	assert.Equal(t, "not in July", Describe(f))
+	f = Not(Or(FilterMonths(July), FilterMonthDays(1)))
+	assert.Equal(t, "not (in July or on 1st day of month)", Describe(f))
+}
+
+func Test_FilterWeekdays(t *testing.T) {
+	f := FilterWeekdays(Monday, Friday, Weekday(7), Weekday(-1))
+	assert.True(t, f.Contains(New(2026, October, 12)))
+	assert.False(t, f.Contains(New(2026, October, 13)))
+	assert.True(t, f.Contains(New(2026, October, 16)))
+	assert.False(t, f.Contains(New(2026, October, 18)))
+	assert.Equal(t, "on Monday or Friday", Describe(f))
+	assert.Equal(t, "no date", Describe(FilterWeekdays()))
+}
+
+func Test_FilterMonths(t *testing.T) {
+	f := FilterMonths(December, January, Month(13))
+	assert.True(t, f.Contains(New(2026, January, 12)))
+	assert.False(t, f.Contains(New(2026, February, 13)))
+	assert.True(t, f.Contains(New(2026, December, 31)))
+	assert.Equal(t, "in January or December", Describe(f))
+}
+
+func Test_FilterMonthDays(t *testing.T) {
+	f := FilterMonthDays(1, 15, -1, -2, 0, 32, -32)
+	assert.True(t, f.Contains(New(2026, February, 1)))
+	assert.True(t, f.Contains(New(2026, February, 15)))
+	assert.False(t, f.Contains(New(2026, February, 16)))
+	assert.True(t, f.Contains(New(2026, February, 27)))
+	assert.True(t, f.Contains(New(2026, February, 28)))
+	assert.False(t, f.Contains(New(2026, March, 28)))
+	assert.True(t, f.Contains(New(2026, March, 30)))
+	assert.True(t, f.Contains(New(2026, March, 31)))
+	assert.Equal(t, "on 1st, 15th, 2nd last or last day of month", Describe(f))
+}
+
+func Test_FilterDates(t *testing.T) {
+	Formatter = DefaultFormatter
+	f := FilterDates(New(2026, July, 4), New(2026, January, 1), New(2026, July, 4))
+	assert.True(t, f.Contains(New(2026, January, 1)))
+	assert.True(t, f.Contains(New(2026, July, 4)))
+	assert.False(t, f.Contains(New(2026, July, 5)))
+	assert.False(t, f.Contains(Date{}))
+	assert.Equal(t, "on 2026-01-01 or 2026-07-04", Describe(f))
+	assert.False(t, FilterDates().Contains(Date{}))
+}
+
+func Test_ordinal(t *testing.T) {
+	assert.Equal(t, "1st", ordinal(1))
+	assert.Equal(t, "2nd", ordinal(2))
+	assert.Equal(t, "3rd", ordinal(3))
+	assert.Equal(t, "4th", ordinal(4))
+	assert.Equal(t, "11th", ordinal(11))
+	assert.Equal(t, "12th", ordinal(12))
+	assert.Equal(t, "13th", ordinal(13))
+	assert.Equal(t, "21st", ordinal(21))
+	assert.Equal(t, "22nd", ordinal(22))
+}
+
+func ExampleAnd() {
+	holiday := New(2026, July, 4)
+	f := And(
+		FilterWeekdays(Monday, Tuesday, Wednesday, Thursday, Friday),
+		FilterMonths(July),
+		Not(FilterDates(holiday)),
+	)
+	fmt.Println(Describe(f))
+	fmt.Println("2026-07-03", f.Contains(New(2026, July, 3)))
+	fmt.Println("2026-07-04", f.Contains(holiday))
+	// Output:
+	// on Monday, Tuesday, Wednesday, Thursday or Friday and in July and not on 2026-07-04
+	// 2026-07-03 true
+	// 2026-07-04 false
+}
+
+func Test_ParseFilter(t *testing.T) {
+	from, to := New(2026, January, 1), New(2026, February, 1)
+	f, err := ParseFilter("..")
+	require.NoError(t, err)
+	assert.Equal(t, filterNo{}, f)
+	f, err = ParseFilter("2026-01-01..")
+	require.NoError(t, err)
+	assert.Equal(t, &filterFrom{from: from}, f)
+	f, err = ParseFilter("..2026-02-01")
+	require.NoError(t, err)
+	assert.Equal(t, &filterTo{to: to}, f)
+	f, err = ParseFilter("2026-01-01..2026-02-01")
+	require.NoError(t, err)
+	assert.Equal(t, &filterFromTo{from: from, to: to}, f)
+	f, err = ParseFilter([]byte("2026-01-01..2026-01-01"))
+	require.NoError(t, err)
+	assert.Equal(t, &filterDate{date: from}, f)
+	f, err = ParseFilter("2026-02-01..2026-01-01")
+	assert.Nil(t, f)
+	assert.True(t, errors.Is(err, ErrInvalidFromOrTo))
+	assert.EqualError(t, err, `date.ParseFilter: "2026-02-01..2026-01-01": invalid from or to: 2026-02-01 > 2026-01-01`)
+}
+
+func Test_FormatFilter(t *testing.T) {
+	Formatter = DefaultFormatter
+	from, to := New(2026, January, 1), New(2026, February, 1)
+	filters := map[string]Filter{
+		"..":                     Any(),
+		"2026-01-01":             &filterDate{date: from},
+		"2026-01-01..":           &filterFrom{from: from},
+		"..2026-02-01":           &filterTo{to: to},
+		"2026-01-01..2026-02-01": &filterFromTo{from: from, to: to},
+	}
+	for expected, f := range filters {
+		b, err := FormatFilter([]byte("A"), f)
+		require.NoError(t, err, expected)
+		assert.Equal(t, "A"+expected, string(b), expected)
+		parsed, err := ParseFilter(b[1:])
+		require.NoError(t, err, expected)
+		assert.Equal(t, f, parsed, expected)
+	}
+	b, err := FormatFilter(nil, FilterMonths(July))
+	assert.Nil(t, b)
+	assert.EqualError(t, err, "date.FormatFilter: invalid type: expected range filter instead of date.filterMonths")
+	for _, f := range []Filter{
+		And(Any()),
+		Or(Any()),
+		Not(Any()),
+		FilterWeekdays(Monday),
+		FilterMonthDays(1),
+		FilterDates(from),
+	} {
+		_, err = FormatFilter(nil, f)
+		assert.ErrorIs(t, err, ErrInvalidType, Describe(f))
+		_, ok := f.(encoding.TextMarshaler)
+		assert.False(t, ok, Describe(f))
+		_, ok = f.(json.Marshaler)
+		assert.False(t, ok, Describe(f))
+	}
+
+	defer func() {
+		Formatter = DefaultFormatter
+	}()
+	Formatter = func(buf []byte, d Date, f Format) ([]byte, error) {
+		return nil, errors.New("error")
+	}
+	_, err = FormatFilter(nil, &filterDate{date: from})
+	assert.EqualError(t, err, "date.FormatFilter: error")
+}
+
+func Test_filter_marshal(t *testing.T) {
+	Formatter = DefaultFormatter
+	from, to := New(2026, January, 1), New(2026, February, 1)
+	filters := map[string]Filter{
+		"..":                     filterNo{},
+		"2026-01-01":             &filterDate{date: from},
+		"2026-01-01..":           &filterFrom{from: from},
+		"..2026-02-01":           &filterTo{to: to},
+		"2026-01-01..2026-02-01": &filterFromTo{from: from, to: to},
+	}
+	for expected, f := range filters {
+		b, err := json.Marshal(f)
+		require.NoError(t, err, expected)
+		assert.Equal(t, `"`+expected+`"`, string(b), expected)
+		r := Range{}
+		require.NoError(t, json.Unmarshal(b, &r), expected)
+		assert.Equal(t, f, r.Filter(), expected)
+		b, err = f.(interface{ MarshalText() ([]byte, error) }).MarshalText()
+		require.NoError(t, err, expected)
+		assert.Equal(t, expected, string(b), expected)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"strconv"
+)
+
+// FiscalPattern allows configuring how FiscalCalendar splits fiscal year into 12 periods.
+// Available patterns are:
+//   Fiscal445
+//   Fiscal454
+//   Fiscal544
+//   FiscalBroadcast
+type FiscalPattern int
+
+const (
+	// Fiscal445 splits every quarter into periods of 4, 4 and 5 weeks.
+	// Extra week of 53-week year is added to the last period.
+	Fiscal445 = FiscalPattern(iota)
+
+	// Fiscal454 splits every quarter into periods of 4, 5 and 4 weeks.
+	// Extra week of 53-week year is added to the last period.
+	Fiscal454
+
+	// Fiscal544 splits every quarter into periods of 5, 4 and 4 weeks.
+	// Extra week of 53-week year is added to the last period.
+	Fiscal544
+
+	// FiscalBroadcast ends every period (month) the same way as fiscal year ends, but in each calendar month.
+	// Periods have 4 or 5 weeks.
+	FiscalBroadcast
+)
+
+// BroadcastCalendar is standard broadcast calendar used in TV and radio advertising.
+// Broadcast weeks start on Monday, broadcast months end on the last Sunday of calendar month,
+// i.e. they start on Monday of week containing the first day of calendar month.
+var BroadcastCalendar = FiscalCalendar{Pattern: FiscalBroadcast, EndMonth: December, EndWeekday: Sunday}
+
+// FiscalCalendar represents week based fiscal calendar (also known as 52-53-week calendar),
+// e.g. 4-4-5 retail calendar or broadcast calendar.
+// Fiscal year consists of 52 or 53 whole weeks, 4 quarters and 12 periods (3 in every quarter).
+// Fiscal year is numbered by calendar year in which it ends.
+// Zero value is 4-4-5 calendar with fiscal year ending on the last Sunday of December.
+type FiscalCalendar struct {
+	// Pattern of periods, e.g. Fiscal445 or FiscalBroadcast.
+	Pattern FiscalPattern
+
+	// EndMonth is month in which fiscal year ends.
+	// If zero, December is used.
+	EndMonth Month
+
+	// EndWeekday is the last day of fiscal week, i.e. fiscal year ends on this weekday.
+	EndWeekday Weekday
+
+	// Nearest ends fiscal year on EndWeekday nearest to the last day of EndMonth (it can be in next month).
+	// Otherwise, fiscal year ends on the last EndWeekday of EndMonth.
+	Nearest bool
+}
+
+// FiscalDate is position of date in fiscal calendar.
+type FiscalDate struct {
+	// Year is fiscal year, i.e. calendar year in which fiscal year ends.
+	Year int
+
+	// Quarter is fiscal quarter (1-4).
+	Quarter int
+
+	// Period is fiscal period (1-12), i.e. fiscal or broadcast month.
+	Period int
+
+	// Week is fiscal week (1-53).
+	Week int
+}
+
+// Of returns position of passed date in fiscal calendar.
+func (c FiscalCalendar) Of(d Date) FiscalDate {
+	year := d.Year()
+	if d.After(c.yearEnd(year)) {
+		year++
+	} else if !d.After(c.yearEnd(year - 1)) {
+		year--
+	}
+	start := c.yearEnd(year-1).Add(0, 0, 1)
+	period := 1
+	for period < 12 && d.After(c.periodEnd(year, start, period)) {
+		period++
+	}
+	return FiscalDate{
+		Year:    year,
+		Quarter: (period-1)/3 + 1,
+		Period:  period,
+		Week:    d.DaysBetween(start)/7 + 1,
+	}
+}
+
+// Year returns range of whole fiscal year.
+func (c FiscalCalendar) Year(year int) Range {
+	return Range{from: c.yearEnd(year-1).Add(0, 0, 1), to: c.yearEnd(year), hasFrom: true, hasTo: true}
+}
+
+// Weeks returns count of weeks in fiscal year (52 or 53).
+func (c FiscalCalendar) Weeks(year int) int {
+	return c.Year(year).Len() / 7
+}
+
+// Periods returns ranges of all 12 periods of fiscal year.
+func (c FiscalCalendar) Periods(year int) []Range {
+	start := c.yearEnd(year-1).Add(0, 0, 1)
+	from := start
+	periods := make([]Range, 12)
+	for i := range periods {
+		to := c.periodEnd(year, start, i+1)
+		periods[i] = Range{from: from, to: to, hasFrom: true, hasTo: true}
+		from = to.Add(0, 0, 1)
+	}
+	return periods
+}
+
+// Period returns range of fiscal period (1-12).
+// Period out of range is normalized, e.g. period 13 is the first period of next fiscal year.
+func (c FiscalCalendar) Period(year, period int) Range {
+	year += (period - 1) / 12
+	if period = (period-1)%12 + 1; period < 1 {
+		year--
+		period += 12
+	}
+	return c.Periods(year)[period-1]
+}
+
+// Quarter returns range of fiscal quarter (1-4).
+// Quarter out of range is normalized, e.g. quarter 5 is the first quarter of next fiscal year.
+func (c FiscalCalendar) Quarter(year, quarter int) Range {
+	first := c.Period(year, quarter*3-2)
+	last := c.Period(year, quarter*3)
+	first.to = last.to
+	return first
+}
+
+// FilterPeriods creates filter accepting dates in passed fiscal periods (1-12) of any fiscal year.
+// Invalid periods are ignored.
+func (c FiscalCalendar) FilterPeriods(periods ...int) Filter {
+	f := &filterFiscal{c: c}
+	for _, p := range periods {
+		if p >= 1 && p <= 12 {
+			f.periods |= 1 << p
+		}
+	}
+	return f
+}
+
+// FilterQuarters creates filter accepting dates in passed fiscal quarters (1-4) of any fiscal year.
+// Invalid quarters are ignored.
+func (c FiscalCalendar) FilterQuarters(quarters ...int) Filter {
+	f := &filterFiscal{c: c, quarters: true}
+	for _, q := range quarters {
+		if q >= 1 && q <= 4 {
+			f.periods |= 7 << (q*3 - 2)
+		}
+	}
+	return f
+}
+
+// monthEnd returns the last day of fiscal period ending in passed calendar month.
+func (c FiscalCalendar) monthEnd(year int, month Month) Date {
+	last := New(year, month, 1).EndOfMonth()
+	diff := (int(last.Weekday()) - int(c.EndWeekday) + 7) % 7
+	if c.Nearest && diff > 3 {
+		return last.Add(0, 0, 7-diff)
+	}
+	return last.Add(0, 0, -diff)
+}
+
+// yearEnd returns the last day of fiscal year.
+func (c FiscalCalendar) yearEnd(year int) Date {
+	month := c.EndMonth
+	if month == 0 {
+		month = December
+	}
+	return c.monthEnd(year, month)
+}
+
+// periodEnd returns the last day of fiscal period (1-12) of fiscal year starting on passed date.
+func (c FiscalCalendar) periodEnd(year int, start Date, period int) Date {
+	if period == 12 {
+		return c.yearEnd(year)
+	}
+	if c.Pattern == FiscalBroadcast {
+		month := c.EndMonth
+		if month == 0 {
+			month = December
+		}
+		return c.monthEnd(year-1, month+Month(period))
+	}
+	weeks := 0
+	for p := 0; p < period; p++ {
+		weeks += 4
+		if p%3 == 2-int(c.Pattern) {
+			weeks++
+		}
+	}
+	return start.Add(0, 0, weeks*7-1)
+}
+
+// filterFiscal is bitmask of accepted fiscal periods.
+type filterFiscal struct {
+	c        FiscalCalendar
+	periods  uint16
+	quarters bool
+}
+
+func (f *filterFiscal) Contains(date Date) bool {
+	return f.periods&(1<<f.c.Of(date).Period) != 0
+}
+
+func (f *filterFiscal) Describe() string {
+	names := []string(nil)
+	if f.quarters {
+		for q := 1; q <= 4; q++ {
+			if f.periods&(1<<(q*3)) != 0 {
+				names = append(names, strconv.Itoa(q))
+			}
+		}
+		return describeWords("in fiscal quarter ", names, "")
+	}
+	for p := 1; p <= 12; p++ {
+		if f.periods&(1<<p) != 0 {
+			names = append(names, strconv.Itoa(p))
+		}
+	}
+	return describeWords("in fiscal period ", names, "")
+}
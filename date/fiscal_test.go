@@ -0,0 +1,106 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BroadcastCalendar(t *testing.T) {
+	c := BroadcastCalendar
+	assert.Equal(t, "2025-12-29..2026-12-27", c.Year(2026).String())
+	assert.Equal(t, 52, c.Weeks(2026))
+	assert.Equal(t, FiscalDate{Year: 2026, Quarter: 4, Period: 10, Week: 42}, c.Of(New(2026, October, 18)))
+	assert.Equal(t, FiscalDate{Year: 2027, Quarter: 1, Period: 1, Week: 1}, c.Of(New(2026, December, 31)))
+	assert.Equal(t, FiscalDate{Year: 2026, Quarter: 1, Period: 1, Week: 1}, c.Of(New(2025, December, 29)))
+	periods := c.Periods(2026)
+	require.Len(t, periods, 12)
+	assert.Equal(t, "2025-12-29..2026-01-25", periods[0].String())
+	assert.Equal(t, "2026-01-26..2026-02-22", periods[1].String())
+	assert.Equal(t, "2026-09-28..2026-10-25", periods[9].String())
+	assert.Equal(t, "2026-11-30..2026-12-27", periods[11].String())
+	for i, p := range periods {
+		from, _ := p.From()
+		to, _ := p.To()
+		assert.Equal(t, Monday, from.Weekday(), i)
+		assert.Equal(t, Sunday, to.Weekday(), i)
+		if i > 0 {
+			prev, _ := periods[i-1].To()
+			assert.Equal(t, prev.Add(0, 0, 1), from, i)
+		}
+	}
+}
+
+func Test_FiscalCalendar_pattern(t *testing.T) {
+	weeks := func(r Range) int {
+		return r.Len() / 7
+	}
+	c := FiscalCalendar{}
+	p := c.Periods(2026)
+	assert.Equal(t, []int{4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5}, []int{
+		weeks(p[0]), weeks(p[1]), weeks(p[2]), weeks(p[3]), weeks(p[4]), weeks(p[5]),
+		weeks(p[6]), weeks(p[7]), weeks(p[8]), weeks(p[9]), weeks(p[10]), weeks(p[11]),
+	})
+
+	// NRF retail calendar, fiscal year ending in 2024 has 53 weeks
+	c = FiscalCalendar{Pattern: Fiscal454, EndMonth: January, EndWeekday: Saturday, Nearest: true}
+	assert.Equal(t, "2023-01-29..2024-02-03", c.Year(2024).String())
+	assert.Equal(t, 53, c.Weeks(2024))
+	assert.Equal(t, 52, c.Weeks(2025))
+	p = c.Periods(2024)
+	assert.Equal(t, []int{4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 5}, []int{
+		weeks(p[0]), weeks(p[1]), weeks(p[2]), weeks(p[3]), weeks(p[4]), weeks(p[5]),
+		weeks(p[6]), weeks(p[7]), weeks(p[8]), weeks(p[9]), weeks(p[10]), weeks(p[11]),
+	})
+	assert.Equal(t, FiscalDate{Year: 2024, Quarter: 4, Period: 12, Week: 53}, c.Of(New(2024, February, 3)))
+	assert.Equal(t, FiscalDate{Year: 2025, Quarter: 1, Period: 1, Week: 1}, c.Of(New(2024, February, 4)))
+	assert.Equal(t, FiscalDate{Year: 2024, Quarter: 1, Period: 1, Week: 1}, c.Of(New(2023, January, 29)))
+
+	c = FiscalCalendar{Pattern: Fiscal544, EndWeekday: Friday}
+	p = c.Periods(2026)
+	assert.Equal(t, []int{5, 4, 4}, []int{weeks(p[0]), weeks(p[1]), weeks(p[2])})
+}
+
+func Test_FiscalCalendar_Period(t *testing.T) {
+	c := BroadcastCalendar
+	assert.Equal(t, c.Periods(2027)[0], c.Period(2026, 13))
+	assert.Equal(t, c.Periods(2025)[11], c.Period(2026, 0))
+	assert.Equal(t, c.Periods(2024)[11], c.Period(2026, -12))
+	assert.Equal(t, "2026-09-28..2026-12-27", c.Quarter(2026, 4).String())
+	assert.Equal(t, "2026-12-28..2027-03-28", c.Quarter(2026, 5).String())
+}
+
+func Test_FiscalCalendar_Of(t *testing.T) {
+	c := FiscalCalendar{Pattern: Fiscal445, EndMonth: June, EndWeekday: Saturday, Nearest: true}
+	for d := New(2020, January, 1); d.Before(New(2030, January, 1)); d = d.Add(0, 0, 1) {
+		f := c.Of(d)
+		require.True(t, c.Year(f.Year).Contains(d), d)
+		require.True(t, c.Period(f.Year, f.Period).Contains(d), d)
+		require.True(t, c.Quarter(f.Year, f.Quarter).Contains(d), d)
+		from, _ := c.Year(f.Year).From()
+		require.Equal(t, from.Add(0, 0, (f.Week-1)*7).StartOfWeek(from.Weekday()), d.StartOfWeek(from.Weekday()), d)
+	}
+}
+
+func Test_FiscalCalendar_filter(t *testing.T) {
+	c := BroadcastCalendar
+	f := c.FilterPeriods(1, 12, 13)
+	assert.True(t, f.Contains(New(2025, December, 29)))
+	assert.True(t, f.Contains(New(2026, January, 25)))
+	assert.False(t, f.Contains(New(2026, January, 26)))
+	assert.True(t, f.Contains(New(2026, December, 1)))
+	assert.Equal(t, "in fiscal period 1 or 12", Describe(f))
+
+	f = c.FilterQuarters(2, 4, 0)
+	assert.False(t, f.Contains(New(2026, March, 29)))
+	assert.True(t, f.Contains(New(2026, March, 30)))
+	assert.True(t, f.Contains(New(2026, December, 27)))
+	assert.False(t, f.Contains(New(2026, December, 28)))
+	assert.Equal(t, "in fiscal quarter 2 or 4", Describe(f))
+	assert.Equal(t, "no date", Describe(c.FilterPeriods()))
+}
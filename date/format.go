@@ -11,11 +11,38 @@ import (
 // Format allows configuring Formatter behavior.
 // Available format flags are:
 //   FormatBasic
+//   FormatWeek
+//   FormatOrdinal
+//   FormatLong
+//   FormatSeconds
+//   FormatExpanded
 type Format int
 
 const (
 	// FormatBasic enforce date format without separators, i.e. YYYYMMDD.
+	// It can be combined with FormatWeek (i.e. YYYYWwwD) and FormatOrdinal (i.e. YYYYDDD).
 	FormatBasic = Format(1 << iota)
+
+	// FormatWeek enforce ISO 8601 week date format, i.e. YYYY-Www-D.
+	// Year is ISO 8601 week-numbering year, it can differ from calendar year.
+	FormatWeek
+
+	// FormatOrdinal enforce ISO 8601 ordinal date format, i.e. YYYY-DDD.
+	// If FormatWeek is also present, FormatOrdinal is ignored.
+	FormatOrdinal
+
+	// FormatLong enforce long localized format using LongLayout of DefaultLocale, e.g. "Sunday, 18 October 2026".
+	// If present, other flags are ignored.
+	FormatLong
+
+	// FormatSeconds enforce seconds in time of day, i.e. hh:mm:ss.
+	// It is used by DefaultClockFormatter and DefaultDateTimeFormatter, DefaultFormatter ignores it.
+	FormatSeconds
+
+	// FormatExpanded enforce ISO 8601 expanded year representation with sign and at least 5 digits, i.e. ±YYYYY-MM-DD.
+	// It can be combined with FormatBasic, FormatWeek and FormatOrdinal.
+	// Negative years are formatted in expanded representation even without FormatExpanded.
+	FormatExpanded
 )
 
 var (
@@ -25,12 +52,40 @@ var (
 
 // DefaultFormatter formats date.
 // Default format is ISO 8601 extended format, i.e. YYYY-MM-DD.
-// It reacts to Format flags and never returns error.
+// Year 0 is 1 BC, year -1 is 2 BC and so on (astronomical year numbering), e.g. year -1 is formatted as -00001.
+// It reacts to Format flags and returns error only if FormatLong is present and LongLayout of DefaultLocale is invalid.
 func DefaultFormatter(buf []byte, d Date, f Format) ([]byte, error) {
-	format := `%04d-%02d-%02d`
-	if f&FormatBasic != 0 {
-		format = `%04d%02d%02d`
+	basic := f&FormatBasic != 0
+	switch {
+	case f&FormatLong != 0:
+		return formatLayout(buf, d, DefaultLocale.LongLayout, DefaultLocale)
+	case f&FormatWeek != 0:
+		format := `-W%02d-%d`
+		if basic {
+			format = `W%02d%d`
+		}
+		year, week := d.ISOWeek()
+		return internal.Bprintf(buf, yearFormat(int64(year), f)+format, year, week, isoWeekday(d.Weekday())), nil
+	case f&FormatOrdinal != 0:
+		format := `-%03d`
+		if basic {
+			format = `%03d`
+		}
+		year := int64(d.year) + 1 // Date.Year overflows 32-bit int for the last year
+		return internal.Bprintf(buf, yearFormat(year, f)+format, year, d.YearDay()), nil
+	}
+	format := `-%02d-%02d`
+	if basic {
+		format = `%02d%02d`
+	}
+	year := int64(d.year) + 1 // Date.Year overflows 32-bit int for the last year
+	return internal.Bprintf(buf, yearFormat(year, f)+format, year, d.month+1, d.day+1), nil
+}
+
+// yearFormat returns format of year used by DefaultFormatter.
+func yearFormat(year int64, f Format) string {
+	if f&FormatExpanded != 0 || year < 0 {
+		return `%+06d`
 	}
-	year, month, day := d.Date()
-	return internal.Bprintf(buf, format, year, month, day), nil
+	return `%04d`
 }
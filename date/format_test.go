@@ -5,6 +5,7 @@
 package date
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,4 +26,26 @@ func Test_DefaultFormatter(t *testing.T) {
 	assertDefaultFormatter(t, `2002-08-07`, New(2002, August, 7), 0)
 	assertDefaultFormatter(t, `00010101`, Date{}, FormatBasic)
 	assertDefaultFormatter(t, `20020807`, New(2002, August, 7), FormatBasic)
+	assertDefaultFormatter(t, `0001-W01-1`, Date{}, FormatWeek)
+	assertDefaultFormatter(t, `2026-W42-3`, New(2026, October, 14), FormatWeek)
+	assertDefaultFormatter(t, `2026W427`, New(2026, October, 18), FormatWeek|FormatBasic)
+	assertDefaultFormatter(t, `2020-W53-5`, New(2021, January, 1), FormatWeek)
+	assertDefaultFormatter(t, `2009-W01-1`, New(2008, December, 29), FormatWeek|FormatOrdinal)
+	assertDefaultFormatter(t, `0001-001`, Date{}, FormatOrdinal)
+	assertDefaultFormatter(t, `2026-291`, New(2026, October, 18), FormatOrdinal)
+	assertDefaultFormatter(t, `2020366`, New(2020, December, 31), FormatOrdinal|FormatBasic)
+}
+
+func Test_DefaultFormatter_expanded(t *testing.T) {
+	assertDefaultFormatter(t, `+02026-10-18`, New(2026, October, 18), FormatExpanded)
+	assertDefaultFormatter(t, `+12026-01-01`, New(12026, January, 1), FormatExpanded)
+	assertDefaultFormatter(t, `+120260101`, New(12026, January, 1), FormatExpanded|FormatBasic)
+	assertDefaultFormatter(t, `+00000-12-31`, New(0, December, 31), FormatExpanded)
+	assertDefaultFormatter(t, `0000-12-31`, New(0, December, 31), 0)
+	assertDefaultFormatter(t, `-00044-03-15`, New(-44, March, 15), 0)
+	assertDefaultFormatter(t, `-00044-03-15`, New(-44, March, 15), FormatExpanded)
+	assertDefaultFormatter(t, `+02026-W42-7`, New(2026, October, 18), FormatExpanded|FormatWeek)
+	assertDefaultFormatter(t, `-00004-366`, New(-4, December, 31), FormatOrdinal)
+	assertDefaultFormatter(t, `+2147483648-12-31`, Date{year: math.MaxInt32, month: 11, day: 30}, FormatExpanded)
+	assertDefaultFormatter(t, `-2147483647-01-01`, Date{year: math.MinInt32}, 0)
 }
@@ -0,0 +1,63 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+// MonthGrid is calendar grid of month for rendering month views, i.e. 6 weeks (rows) of 7 days (columns).
+// Grid has always 6 weeks, so all months have the same height,
+// days of previous and next month fill the first and the last weeks.
+type MonthGrid struct {
+	// Month is month of grid.
+	Month YearMonth `json:"month"`
+
+	// FirstDay is weekday of the first column.
+	FirstDay Weekday `json:"firstDay"`
+
+	// Weeks are rows of grid.
+	Weeks [6]GridWeek `json:"weeks"`
+}
+
+// GridWeek is one row of MonthGrid.
+type GridWeek struct {
+	// Week is ISO 8601 week number (1-53) of Thursday in row.
+	// If row does not start on Monday, it spans two ISO weeks and Thursday is always in the one with more days of row.
+	Week int `json:"week"`
+
+	// Days are cells of row.
+	Days [7]GridDay `json:"days"`
+}
+
+// GridDay is one cell of MonthGrid.
+type GridDay struct {
+	// Date of cell.
+	Date Date `json:"date"`
+
+	// Outside is true if date belongs to previous or next month.
+	Outside bool `json:"outside"`
+
+	// Flagged is true if date is accepted by filter passed to NewMonthGrid, e.g. day with events.
+	Flagged bool `json:"flagged"`
+}
+
+// NewMonthGrid creates calendar grid of month with weeks starting on passed weekday, e.g. Monday or Sunday.
+// If filter is not nil, days accepted by filter are flagged (including days outside of month).
+func NewMonthGrid(m YearMonth, firstDay Weekday, f Filter) MonthGrid {
+	first := m.FirstDay()
+	offset := (int(first.Weekday()) - int(firstDay)%7 + 14) % 7
+	d := first.Add(0, 0, -offset)
+	g := MonthGrid{Month: m, FirstDay: firstDay}
+	for i := range g.Weeks {
+		w := &g.Weeks[i]
+		_, w.Week = d.Add(0, 0, (int(Thursday)-int(d.Weekday())+7)%7).ISOWeek()
+		for j := range w.Days {
+			w.Days[j] = GridDay{
+				Date:    d,
+				Outside: !m.Contains(d),
+				Flagged: f != nil && f.Contains(d),
+			}
+			d = d.Add(0, 0, 1)
+		}
+	}
+	return g
+}
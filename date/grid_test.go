@@ -0,0 +1,94 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewMonthGrid(t *testing.T) {
+	october := NewYearMonth(2026, October)
+	g := NewMonthGrid(october, Monday, nil)
+	assert.Equal(t, october, g.Month)
+	assert.Equal(t, Monday, g.FirstDay)
+	assert.Equal(t, GridDay{Date: New(2026, September, 28), Outside: true}, g.Weeks[0].Days[0])
+	assert.Equal(t, GridDay{Date: New(2026, October, 1)}, g.Weeks[0].Days[3])
+	assert.Equal(t, GridDay{Date: New(2026, October, 31)}, g.Weeks[4].Days[5])
+	assert.Equal(t, GridDay{Date: New(2026, November, 8), Outside: true}, g.Weeks[5].Days[6])
+	assertGridWeeks(t, []int{40, 41, 42, 43, 44, 45}, g)
+
+	outside := 0
+	for _, w := range g.Weeks {
+		for i, d := range w.Days {
+			assert.Equal(t, (int(Monday)+i)%7, int(d.Date.Weekday()), d.Date)
+			assert.False(t, d.Flagged)
+			if d.Outside {
+				outside++
+			}
+		}
+	}
+	assert.Equal(t, 42-31, outside)
+
+	g = NewMonthGrid(october, Sunday, FilterWeekdays(Saturday))
+	assert.Equal(t, New(2026, September, 27), g.Weeks[0].Days[0].Date)
+	assert.Equal(t, GridDay{Date: New(2026, October, 3), Flagged: true}, g.Weeks[0].Days[6])
+	assert.Equal(t, GridDay{Date: New(2026, November, 7), Outside: true, Flagged: true}, g.Weeks[5].Days[6])
+	assert.False(t, g.Weeks[0].Days[5].Flagged)
+	assertGridWeeks(t, []int{40, 41, 42, 43, 44, 45}, g)
+
+	// February 2026 starts on Sunday
+	g = NewMonthGrid(NewYearMonth(2026, February), Sunday, nil)
+	assert.Equal(t, GridDay{Date: New(2026, February, 1)}, g.Weeks[0].Days[0])
+	assert.Equal(t, GridDay{Date: New(2026, March, 1), Outside: true}, g.Weeks[4].Days[0])
+	g = NewMonthGrid(NewYearMonth(2026, February), Monday, nil)
+	assert.Equal(t, GridDay{Date: New(2026, February, 1)}, g.Weeks[0].Days[6])
+
+	// row from Saturday to Friday spans two ISO weeks
+	g = NewMonthGrid(NewYearMonth(2027, January), Saturday, NewSet(New(2027, January, 1)))
+	assert.Equal(t, GridDay{Date: New(2026, December, 26), Outside: true}, g.Weeks[0].Days[0])
+	assert.Equal(t, GridDay{Date: New(2027, January, 1), Flagged: true}, g.Weeks[0].Days[6])
+	assertGridWeeks(t, []int{53, 1, 2, 3, 4, 5}, g)
+}
+
+func Test_MonthGrid_json(t *testing.T) {
+	Formatter = DefaultFormatter
+	g := NewMonthGrid(NewYearMonth(2026, October), Monday, FilterDates(New(2026, October, 18)))
+	b, err := json.Marshal(g)
+	require.NoError(t, err)
+	v := struct {
+		Month    string `json:"month"`
+		FirstDay int    `json:"firstDay"`
+		Weeks    []struct {
+			Week int `json:"week"`
+			Days []struct {
+				Date    string `json:"date"`
+				Outside bool   `json:"outside"`
+				Flagged bool   `json:"flagged"`
+			} `json:"days"`
+		} `json:"weeks"`
+	}{}
+	require.NoError(t, json.Unmarshal(b, &v))
+	assert.Equal(t, "2026-10", v.Month)
+	assert.Equal(t, 1, v.FirstDay)
+	require.Len(t, v.Weeks, 6)
+	assert.Equal(t, 42, v.Weeks[2].Week)
+	require.Len(t, v.Weeks[2].Days, 7)
+	assert.Equal(t, "2026-10-18", v.Weeks[2].Days[6].Date)
+	assert.True(t, v.Weeks[2].Days[6].Flagged)
+	assert.False(t, v.Weeks[2].Days[6].Outside)
+}
+
+func assertGridWeeks(t *testing.T, expected []int, g MonthGrid) {
+	t.Helper()
+	weeks := make([]int, len(g.Weeks))
+	for i, w := range g.Weeks {
+		weeks[i] = w.Week
+	}
+	assert.Equal(t, expected, weeks)
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const (
+	secondsPerDay      = 24 * 60 * 60
+	millisecondsPerDay = 1000 * secondsPerDay
+)
+
+var (
+	// DisableMarshalJSONStringForm allows disabling string form at Date.MarshalJSON.
+	DisableMarshalJSONStringForm = false
+
+	// DisableMarshalJSONObjectForm allows disabling object form at Date.MarshalJSON.
+	DisableMarshalJSONObjectForm = false
+
+	// DisableMarshalJSONUnixForm allows disabling Unix time form in seconds at Date.MarshalJSON.
+	DisableMarshalJSONUnixForm = false
+)
+
+// jsonObject is JSON object form of date.
+type jsonObject struct {
+	Year  *int `json:"year"`
+	Month *int `json:"month"`
+	Day   *int `json:"day"`
+}
+
+// marshalJSON converts date to JSON value depending on DisableMarshalJSON* variables.
+func (d Date) marshalJSON() ([]byte, error) {
+	if !DisableMarshalJSONStringForm {
+		b, err := Formatter(nil, d, 0)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(string(b))
+	}
+
+	if !DisableMarshalJSONObjectForm {
+		b := make([]byte, 0, 40)
+		b = append(b, `{"year":`...)
+		b = strconv.AppendInt(b, int64(d.Year()), 10)
+		b = append(b, `,"month":`...)
+		b = strconv.AppendInt(b, int64(d.Month()), 10)
+		b = append(b, `,"day":`...)
+		b = strconv.AppendInt(b, int64(d.Day()), 10)
+		return append(b, '}'), nil
+	}
+
+	days := d.unixDays()
+	if !DisableMarshalJSONUnixForm {
+		return strconv.AppendInt(nil, days*secondsPerDay, 10), nil
+	}
+	if days < math.MinInt64/millisecondsPerDay || days > math.MaxInt64/millisecondsPerDay {
+		return nil, fmt.Errorf("%w: %s in milliseconds", ErrOutOfRange, d)
+	}
+	return strconv.AppendInt(nil, days*millisecondsPerDay, 10), nil
+}
+
+// unmarshalJSON parses date from JSON value depending on passed rule.
+// JSON null must be handled by caller.
+func unmarshalJSON(data []byte, r Rule) (Date, error) {
+	if len(data) == 0 {
+		return Date{}, fmt.Errorf("%w: empty data", ErrInvalidType)
+	}
+	switch c := data[0]; {
+	case c == '"':
+		s := ""
+		if err := json.Unmarshal(data, &s); err != nil {
+			return Date{}, err
+		}
+		return Parser([]byte(s), r)
+	case c == '{':
+		if r&RuleEnableJSONObjectForm == 0 {
+			return Date{}, ErrObjectFormDisabled
+		}
+		return unmarshalJSONObject(data)
+	case c == '-' || (c >= '0' && c <= '9'):
+		if r&(RuleEnableJSONUnixForm|RuleEnableJSONUnixMilliForm) == 0 {
+			return Date{}, ErrUnixFormDisabled
+		}
+		n, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return Date{}, fmt.Errorf("%w: expected integer instead of %s", ErrInvalidType, data)
+		}
+		unit := int64(secondsPerDay)
+		if r&RuleEnableJSONUnixMilliForm != 0 {
+			unit = millisecondsPerDay
+		}
+		days := n / unit
+		if n%unit < 0 {
+			days--
+		}
+		if days < minUnixDays || days > maxUnixDays {
+			return Date{}, fmt.Errorf("%w: %d", ErrOutOfRange, n)
+		}
+		return fromUnixDays(days), nil
+	}
+	return Date{}, fmt.Errorf("%w: expected JSON string, object or number instead of %s", ErrInvalidType, data)
+}
+
+// unmarshalJSONObject parses JSON object form, all keys are required and no other keys are allowed.
+func unmarshalJSONObject(data []byte) (Date, error) {
+	o := jsonObject{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&o); err != nil {
+		return Date{}, fmt.Errorf("%w: %s", ErrInvalidObject, err)
+	}
+	switch {
+	case o.Year == nil:
+		return Date{}, fmt.Errorf("%w: missing year", ErrInvalidObject)
+	case o.Month == nil:
+		return Date{}, fmt.Errorf("%w: missing month", ErrInvalidObject)
+	case o.Day == nil:
+		return Date{}, fmt.Errorf("%w: missing day", ErrInvalidObject)
+	}
+	year, month, day := int64(*o.Year), Month(*o.Month), *o.Day
+	if year-1 < math.MinInt32 || year-1 > math.MaxInt32 || month < January || month > December || day < 1 || day > monthDays(int(year), month) {
+		return Date{}, fmt.Errorf("%w: invalid date %d-%d-%d", ErrInvalidObject, year, month, day)
+	}
+	return New(int(year), month, day), nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.lstv.dev/util/test"
+)
+
+func Test_Date_MarshalJSON(t *testing.T) {
+	defer func() {
+		DisableMarshalJSONStringForm = false
+		DisableMarshalJSONObjectForm = false
+		DisableMarshalJSONUnixForm = false
+		Formatter = DefaultFormatter
+	}()
+	test.MarshalJSON(t, []test.CaseJSON[Date]{
+		{ // 0
+			Data:  `"2026-10-18"`,
+			Value: New(2026, October, 18),
+		},
+		{ // 1
+			Data:  `"0001-01-01"`,
+			Value: Date{},
+		},
+	})
+
+	DisableMarshalJSONStringForm = true
+	test.MarshalJSON(t, []test.CaseJSON[Date]{
+		{ // 0
+			Data:  `{"year":2026,"month":10,"day":18}`,
+			Value: New(2026, October, 18),
+		},
+		{ // 1
+			Data:  `{"year":-44,"month":3,"day":15}`,
+			Value: New(-44, March, 15),
+		},
+	})
+
+	DisableMarshalJSONObjectForm = true
+	test.MarshalJSON(t, []test.CaseJSON[Date]{
+		{ // 0
+			Data:  `1792281600`,
+			Value: New(2026, October, 18),
+		},
+		{ // 1
+			Data:  `-86400`,
+			Value: New(1969, December, 31),
+		},
+	})
+
+	DisableMarshalJSONUnixForm = true
+	test.MarshalJSON(t, []test.CaseJSON[Date]{
+		{ // 0
+			Data:  `1792281600000`,
+			Value: New(2026, October, 18),
+		},
+		{ // 1
+			Error: test.Error("date.Date.MarshalJSON: out of range: 292278995-01-01 in milliseconds"),
+			Value: New(292278995, January, 1),
+		},
+	})
+
+	DisableMarshalJSONStringForm = false
+	Formatter = func(buf []byte, d Date, f Format) ([]byte, error) {
+		return nil, errors.New("format error")
+	}
+	test.MarshalJSON(t, []test.CaseJSON[Date]{
+		{
+			Error: test.Error("date.Date.MarshalJSON: format error"),
+			Value: New(2026, October, 18),
+		},
+	})
+}
+
+func Test_Date_UnmarshalJSON(t *testing.T) {
+	defer func() {
+		DefaultRule = 0
+	}()
+	test.UnmarshalJSON(t, []test.CaseJSON[Date]{
+		{ // 0
+			Data:  `"2026-10-18"`,
+			Value: New(2026, October, 18),
+		},
+		{ // 1
+			Error: test.Error(`date.Date.UnmarshalJSON: date.DefaultParser: "2026-10-32": invalid date`),
+			Data:  `"2026-10-32"`,
+		},
+		{ // 2
+			Error: test.Error("date.Date.UnmarshalJSON: object form disabled"),
+			Data:  `{"year":2026,"month":10,"day":18}`,
+		},
+		{ // 3
+			Error: test.Error("date.Date.UnmarshalJSON: unix form disabled"),
+			Data:  `1792281600`,
+		},
+		{ // 4
+			Error: test.Error("date.Date.UnmarshalJSON: invalid type: expected JSON string, object or number instead of true"),
+			Data:  `true`,
+		},
+	}, nil)
+
+	DefaultRule = RuleEnableJSONObjectForm | RuleEnableJSONUnixForm
+	test.UnmarshalJSON(t, []test.CaseJSON[Date]{
+		{ // 0
+			Data:  `{"year":2026,"month":10,"day":18}`,
+			Value: New(2026, October, 18),
+		},
+		{ // 1
+			Data:  `{"Day": 15, "month": 3, "year": -44}`,
+			Value: New(-44, March, 15),
+		},
+		{ // 2
+			Error: test.Error("date.Date.UnmarshalJSON: invalid object: missing day"),
+			Data:  `{"year":2026,"month":10}`,
+		},
+		{ // 3
+			Error: test.Error(`date.Date.UnmarshalJSON: invalid object: json: unknown field "hour"`),
+			Data:  `{"year":2026,"month":10,"day":18,"hour":20}`,
+		},
+		{ // 4
+			Error: test.Error("date.Date.UnmarshalJSON: invalid object: invalid date 2026-2-29"),
+			Data:  `{"year":2026,"month":2,"day":29}`,
+		},
+		{ // 5
+			Error: test.Error("date.Date.UnmarshalJSON: invalid object: invalid date 2026-13-1"),
+			Data:  `{"year":2026,"month":13,"day":1}`,
+		},
+		{ // 6
+			Error: test.ErrorHasPrefix("date.Date.UnmarshalJSON: invalid object: json: cannot unmarshal string"),
+			Data:  `{"year":"2026","month":10,"day":18}`,
+		},
+		{ // 7
+			Data:  `1792281600`,
+			Value: New(2026, October, 18),
+		},
+		{ // 8
+			Data:  `1792367999`,
+			Value: New(2026, October, 18),
+		},
+		{ // 9
+			Data:  `-1`,
+			Value: New(1969, December, 31),
+		},
+		{ // 10
+			Error: test.Error("date.Date.UnmarshalJSON: invalid type: expected integer instead of 1792281600.5"),
+			Data:  `1792281600.5`,
+		},
+		{ // 11
+			Error: test.Error("date.Date.UnmarshalJSON: out of range: 9223372036854775807"),
+			Data:  `9223372036854775807`,
+		},
+	}, nil)
+
+	DefaultRule = RuleEnableJSONUnixForm | RuleEnableJSONUnixMilliForm
+	test.UnmarshalJSON(t, []test.CaseJSON[Date]{
+		{ // 0
+			Data:  `1792281600000`,
+			Value: New(2026, October, 18),
+		},
+		{ // 1
+			Data:  `1792281600`,
+			Value: New(1970, January, 21),
+		},
+	}, nil)
+
+	d := New(2026, October, 18)
+	require.NoError(t, d.UnmarshalJSON([]byte(`null`)))
+	assert.Equal(t, New(2026, October, 18), d)
+	DefaultRule = RuleEnableJSONNull
+	require.NoError(t, d.UnmarshalJSON([]byte(`null`)))
+	assert.Zero(t, d)
+
+	err := d.UnmarshalJSON([]byte(`{}`))
+	assert.True(t, errors.Is(err, ErrObjectFormDisabled))
+	err = d.UnmarshalJSON([]byte(`0`))
+	assert.True(t, errors.Is(err, ErrUnixFormDisabled))
+}
+
+func Test_Date_json_field(t *testing.T) {
+	defer func() {
+		DisableMarshalJSONStringForm = false
+		DefaultRule = 0
+	}()
+	v := struct {
+		A Date `json:"a"`
+		B Date `json:"b"`
+	}{
+		A: New(2026, October, 18),
+	}
+	DisableMarshalJSONStringForm = true
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":{"year":2026,"month":10,"day":18},"b":{"year":1,"month":1,"day":1}}`, string(b))
+
+	DefaultRule = RuleEnableJSONObjectForm | RuleEnableJSONNull
+	v.A, v.B = Date{}, New(2000, January, 1)
+	require.NoError(t, json.Unmarshal([]byte(`{"a":{"year":2026,"month":10,"day":18},"b":null}`), &v))
+	assert.Equal(t, New(2026, October, 18), v.A)
+	assert.Zero(t, v.B)
+}
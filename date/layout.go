@@ -0,0 +1,305 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"strings"
+
+	"go.lstv.dev/util/constraint"
+	"go.lstv.dev/util/internal"
+)
+
+// FormatLayout appends date formatted by strftime-like layout to buf.
+// Supported verbs are:
+//
+//   ┌ Verb ┬ Meaning ─────────────────────────────┬ Example ──┐
+//   │ %Y   │ year, at least four digits           │ 2006      │
+//   │ %y   │ year without century, two digits     │ 06        │
+//   │ %m   │ month, two digits                    │ 01        │
+//   │ %-m  │ month without padding                │ 1         │
+//   │ %d   │ day of the month, two digits         │ 02        │
+//   │ %-d  │ day of the month without padding     │ 2         │
+//   │ %e   │ day of the month padded by space     │  2        │
+//   │ %j   │ day of the year, three digits        │ 002       │
+//   │ %-j  │ day of the year without padding      │ 2         │
+//   │ %B   │ month name, genitive case if any     │ January   │
+//   │ %OB  │ month name, nominative case          │ January   │
+//   │ %b   │ abbreviated month name               │ Jan       │
+//   │ %A   │ weekday name                         │ Monday    │
+//   │ %a   │ abbreviated weekday name             │ Mon       │
+//   │ %%   │ percent sign                         │ %         │
+//   └──────┴──────────────────────────────────────┴───────────┘
+//
+// Any other character is copied as is.
+// Names are taken from DefaultLocale, see FormatLayoutIn to use another locale.
+// Genitive case of %B is intended to be used together with day of the month (e.g. "18. října" in Czech),
+// while %OB is intended for month name alone (e.g. "říjen 2026").
+// It can return wrapped ErrInvalidLayout if layout contains unsupported verb.
+func FormatLayout(buf []byte, d Date, layout string) ([]byte, error) {
+	b, err := formatLayout(buf, d, layout, DefaultLocale)
+	if err != nil {
+		return nil, fmt.Errorf("date.FormatLayout: %w", err)
+	}
+	return b, nil
+}
+
+// ParseLayout parses date from input using strftime-like layout.
+// See FormatLayout for supported verbs, parsing is inverse to formatting with these exceptions:
+//   %Y accepts four to nine digits, but only four digits if it is followed by another numeric verb.
+//   %y accepts years 1969-2068.
+//   %-m, %-d and %-j accept numbers with or without leading zeros.
+//   %e accepts day with or without leading space.
+//   %B and %OB accept month name in any case (nominative or genitive).
+//   Names are case-insensitive.
+// Layout must contain year and either month and day of the month or day of the year.
+// Weekday is optional, but it must match the date if present.
+// It can return *ParseError, possibly wrapping ErrInvalidLayout.
+func ParseLayout[T constraint.ParserInput](layout string, input T) (Date, error) {
+	return parseLayout("ParseLayout", layout, input, DefaultLocale)
+}
+
+// LayoutFormatter returns function which can be used as Formatter.
+// Returned function formats date using FormatLayout and ignores Format flags.
+//
+// Example:
+//   date.Formatter = date.LayoutFormatter("%d.%m.%Y")
+func LayoutFormatter(layout string) func(buf []byte, d Date, f Format) ([]byte, error) {
+	return func(buf []byte, d Date, _ Format) ([]byte, error) {
+		return formatLayout(buf, d, layout, DefaultLocale)
+	}
+}
+
+// LayoutParser returns function which can be used as Parser.
+// Returned function parses date using ParseLayout and ignores rules.
+//
+// Example:
+//   date.Parser = date.LayoutParser("%-d. %-m. %Y")
+func LayoutParser(layout string) func(input []byte, r Rule) (Date, error) {
+	return func(input []byte, _ Rule) (Date, error) {
+		return parseLayout("LayoutParser", layout, input, DefaultLocale)
+	}
+}
+
+func formatLayout(buf []byte, d Date, layout string, l *Locale) ([]byte, error) {
+	year, month, day := d.Date()
+	for i := 0; i < len(layout); i++ {
+		c := layout[i]
+		if c != '%' {
+			buf = append(buf, c)
+			continue
+		}
+		verb, flag, n := layoutVerb(layout, i)
+		if n == 0 {
+			return nil, fmt.Errorf("%w: unsupported verb %q", ErrInvalidLayout, layout[i:])
+		}
+		i += n - 1
+		switch verb {
+		case 'Y':
+			buf = internal.Bprintf(buf, "%04d", year)
+		case 'y':
+			buf = internal.Bprintf(buf, "%02d", year%100)
+		case 'm':
+			buf = internal.Bprintf(buf, layoutNumberFormat(flag, "%02d"), int(month))
+		case 'd':
+			buf = internal.Bprintf(buf, layoutNumberFormat(flag, "%02d"), day)
+		case 'e':
+			buf = internal.Bprintf(buf, "%2d", day)
+		case 'j':
+			buf = internal.Bprintf(buf, layoutNumberFormat(flag, "%03d"), d.YearDay())
+		case 'B':
+			buf = append(buf, l.month(month, flag != 'O')...)
+		case 'b':
+			buf = append(buf, l.MonthsShort[month-1]...)
+		case 'A':
+			buf = append(buf, l.Weekdays[d.Weekday()]...)
+		case 'a':
+			buf = append(buf, l.WeekdaysShort[d.Weekday()]...)
+		case '%':
+			buf = append(buf, '%')
+		}
+	}
+	return buf, nil
+}
+
+func parseLayout[T constraint.ParserInput](funcName string, layout string, input T, l *Locale) (Date, error) {
+	b := []byte(input)
+	year, month, day, yearDay, weekday := 0, 0, 0, 0, -1
+	hasYear, hasMonth, hasDay, hasYearDay := false, false, false, false
+	p := 0
+	for i := 0; i < len(layout); i++ {
+		c := layout[i]
+		if c != '%' {
+			if p >= len(b) || b[p] != c {
+				return Date{}, newParseError(funcName, input, nil)
+			}
+			p++
+			continue
+		}
+		verb, flag, n := layoutVerb(layout, i)
+		if n == 0 {
+			return Date{}, newParseError(funcName, input, fmt.Errorf("%w: unsupported verb %q", ErrInvalidLayout, layout[i:]))
+		}
+		i += n - 1
+		ok := false
+		switch verb {
+		case 'Y':
+			maxDigits := 9
+			if layoutNumeric(layout, i+1) {
+				maxDigits = 4
+			}
+			year, ok = parseLayoutNumber(b, &p, 4, maxDigits)
+			hasYear = true
+		case 'y':
+			year, ok = parseLayoutNumber(b, &p, 2, 2)
+			if year < 69 {
+				year += 2000
+			} else {
+				year += 1900
+			}
+			hasYear = true
+		case 'm':
+			month, ok = parseLayoutNumber(b, &p, layoutMinDigits(flag, 2), 2)
+			hasMonth = true
+		case 'd':
+			day, ok = parseLayoutNumber(b, &p, layoutMinDigits(flag, 2), 2)
+			hasDay = true
+		case 'e':
+			if p < len(b) && b[p] == ' ' {
+				p++
+			}
+			day, ok = parseLayoutNumber(b, &p, 1, 2)
+			hasDay = true
+		case 'j':
+			yearDay, ok = parseLayoutNumber(b, &p, layoutMinDigits(flag, 3), 3)
+			hasYearDay = true
+		case 'B':
+			month, ok = parseLayoutName(b, &p, l.Months[:], l.MonthsGenitive[:])
+			month++
+			hasMonth = true
+		case 'b':
+			month, ok = parseLayoutName(b, &p, l.MonthsShort[:])
+			month++
+			hasMonth = true
+		case 'A':
+			weekday, ok = parseLayoutName(b, &p, l.Weekdays[:])
+		case 'a':
+			weekday, ok = parseLayoutName(b, &p, l.WeekdaysShort[:])
+		case '%':
+			ok = p < len(b) && b[p] == '%'
+			p++
+		}
+		if !ok {
+			return Date{}, newParseError(funcName, input, nil)
+		}
+	}
+	if p != len(b) {
+		return Date{}, newParseError(funcName, input, nil)
+	}
+	if !hasYear || (!hasYearDay && (!hasMonth || !hasDay)) {
+		return Date{}, newParseError(funcName, input, fmt.Errorf("%w: missing year, month or day", ErrInvalidLayout))
+	}
+	d := Date{}
+	if hasYearDay {
+		if yearDay == 0 || yearDay > yearDays(year) {
+			return Date{}, newParseError(funcName, input, nil)
+		}
+		d = NewYearDay(year, yearDay)
+		if (hasMonth && d.Month() != Month(month)) || (hasDay && d.Day() != day) {
+			return Date{}, newParseError(funcName, input, nil)
+		}
+	} else {
+		if month == 0 || month > 12 || day == 0 || day > monthDays(year, Month(month)) {
+			return Date{}, newParseError(funcName, input, nil)
+		}
+		d = New(year, Month(month), day)
+	}
+	if weekday >= 0 && d.Weekday() != Weekday(weekday) {
+		return Date{}, newParseError(funcName, input, nil)
+	}
+	return d, nil
+}
+
+// layoutVerb returns verb and flag of layout at position i (pointing to '%').
+// Returned n is count of bytes used by verb, zero if verb is not supported.
+func layoutVerb(layout string, i int) (verb, flag byte, n int) {
+	n = 2
+	if i+1 < len(layout) && (layout[i+1] == '-' || layout[i+1] == 'O') {
+		flag, n = layout[i+1], 3
+	}
+	if i+n-1 >= len(layout) {
+		return 0, 0, 0
+	}
+	verb = layout[i+n-1]
+	switch verb {
+	case 'm', 'd', 'j':
+		if flag == 0 || flag == '-' {
+			return verb, flag, n
+		}
+	case 'B':
+		if flag == 0 || flag == 'O' {
+			return verb, flag, n
+		}
+	case 'Y', 'y', 'e', 'b', 'A', 'a', '%':
+		if flag == 0 {
+			return verb, flag, n
+		}
+	}
+	return 0, 0, 0
+}
+
+// layoutNumeric returns true if layout at position i starts with numeric verb.
+func layoutNumeric(layout string, i int) bool {
+	if i >= len(layout) || layout[i] != '%' {
+		return false
+	}
+	verb, _, n := layoutVerb(layout, i)
+	return n != 0 && strings.IndexByte("Yymdej", verb) >= 0
+}
+
+func layoutNumberFormat(flag byte, format string) string {
+	if flag == '-' {
+		return "%d"
+	}
+	return format
+}
+
+func layoutMinDigits(flag byte, digits int) int {
+	if flag == '-' {
+		return 1
+	}
+	return digits
+}
+
+// parseLayoutNumber parses number with minDigits to maxDigits digits from b at position p.
+// Position p is moved after parsed number.
+func parseLayoutNumber(b []byte, p *int, minDigits, maxDigits int) (int, bool) {
+	v, n := 0, 0
+	for ; n < maxDigits && *p < len(b) && b[*p] >= '0' && b[*p] <= '9'; n++ {
+		v = v*10 + int(b[*p]-'0')
+		*p++
+	}
+	return v, n >= minDigits
+}
+
+// parseLayoutName parses the longest name of passed name lists from b at position p.
+// It returns index of parsed name in its list and moves position p after it.
+func parseLayoutName(b []byte, p *int, lists ...[]string) (index int, ok bool) {
+	length := 0
+	for _, names := range lists {
+		for i, s := range names {
+			if s != "" && len(s) > length && len(b)-*p >= len(s) && strings.EqualFold(string(b[*p:*p+len(s)]), s) {
+				index, length = i, len(s)
+			}
+		}
+	}
+	*p += length
+	return index, length != 0
+}
+
+// monthDays returns count of days in month, see monthLength.
+func monthDays(year int, month Month) int {
+	return int(monthLength(int64(year), int64(month)))
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func assertParseLayout(t *testing.T, expected Date, layout, data string) {
+	t.Helper()
+	d, err := ParseLayout(layout, data)
+	assert.Equal(t, expected, d)
+	assert.NoError(t, err)
+}
+
+func assertParseLayoutFail(t *testing.T, error, layout, data string) {
+	t.Helper()
+	d, err := ParseLayout(layout, data)
+	assert.Zero(t, d)
+	assert.EqualError(t, err, error)
+}
+
+func Test_FormatLayout(t *testing.T) {
+	d := New(2026, January, 5)
+	for layout, expected := range map[string]string{
+		"%d.%m.%Y":        "05.01.2026",
+		"%-d. %-m. %Y":    "5. 1. 2026",
+		"%m/%d/%Y":        "01/05/2026",
+		"%e/%y":           " 5/26",
+		"%j %-j":          "005 5",
+		"%A, %B %-d, %Y":  "Monday, January 5, 2026",
+		"%a %b %d":        "Mon Jan 05",
+		"100%% %Y%m%d":    "100% 20260105",
+		"no verbs at all": "no verbs at all",
+	} {
+		b, err := FormatLayout(nil, d, layout)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(b), layout)
+	}
+	b, err := FormatLayout([]byte("x"), New(12026, December, 31), "%Y-%j")
+	require.NoError(t, err)
+	assert.Equal(t, "x12026-365", string(b))
+
+	for _, layout := range []string{"%", "%Q", "%-Y", "%-", "%d.%"} {
+		b, err = FormatLayout(nil, d, layout)
+		assert.Nil(t, b)
+		assert.True(t, errors.Is(err, ErrInvalidLayout), layout)
+	}
+	_, err = FormatLayout(nil, d, "%d %Q")
+	assert.EqualError(t, err, `date.FormatLayout: invalid layout: unsupported verb "%Q"`)
+}
+
+func Test_ParseLayout(t *testing.T) {
+	expected := New(2026, October, 18)
+	assertParseLayout(t, expected, "%d.%m.%Y", "18.10.2026")
+	assertParseLayout(t, expected, "%-d. %-m. %Y", "18. 10. 2026")
+	assertParseLayout(t, New(2026, January, 5), "%-d. %-m. %Y", "5. 1. 2026")
+	assertParseLayout(t, New(2026, January, 5), "%-d. %-m. %Y", "05. 01. 2026")
+	assertParseLayout(t, expected, "%m/%d/%Y", "10/18/2026")
+	assertParseLayout(t, expected, "%Y%m%d", "20261018")
+	assertParseLayout(t, New(12026, October, 18), "%d.%m.%Y", "18.10.12026")
+	assertParseLayout(t, expected, "%e/%m/%y", "18/10/26")
+	assertParseLayout(t, New(2026, October, 8), "%e/%m/%y", " 8/10/26")
+	assertParseLayout(t, New(1999, October, 8), "%e/%m/%y", "8/10/99")
+	assertParseLayout(t, expected, "%Y-%j", "2026-291")
+	assertParseLayout(t, expected, "%Y-%-j %m", "2026-291 10")
+	assertParseLayout(t, expected, "%A, %B %-d, %Y", "Sunday, October 18, 2026")
+	assertParseLayout(t, expected, "%a %b %d %Y", "SUN oct 18 2026")
+	assertParseLayout(t, expected, "%d%%%m%%%Y", "18%10%2026")
+
+	assertParseLayoutFail(t, `date.ParseLayout: invalid date`, "%d.%m.%Y", "")
+	assertParseLayoutFail(t, `date.ParseLayout: "18.10.26": invalid date`, "%d.%m.%Y", "18.10.26")
+	assertParseLayoutFail(t, `date.ParseLayout: "8.10.2026": invalid date`, "%d.%m.%Y", "8.10.2026")
+	assertParseLayoutFail(t, `date.ParseLayout: "18.10.2026 ": invalid date`, "%d.%m.%Y", "18.10.2026 ")
+	assertParseLayoutFail(t, `date.ParseLayout: "18-10-2026": invalid date`, "%d.%m.%Y", "18-10-2026")
+	assertParseLayoutFail(t, `date.ParseLayout: "31.02.2026": invalid date`, "%d.%m.%Y", "31.02.2026")
+	assertParseLayoutFail(t, `date.ParseLayout: "00.10.2026": invalid date`, "%d.%m.%Y", "00.10.2026")
+	assertParseLayoutFail(t, `date.ParseLayout: "18.13.2026": invalid date`, "%d.%m.%Y", "18.13.2026")
+	assertParseLayoutFail(t, `date.ParseLayout: "2026-366": invalid date`, "%Y-%j", "2026-366")
+	assertParseLayoutFail(t, `date.ParseLayout: "2026-291 11": invalid date`, "%Y-%j %m", "2026-291 11")
+	assertParseLayoutFail(t, `date.ParseLayout: "Monday 18.10.2026": invalid date`, "%A %d.%m.%Y", "Monday 18.10.2026")
+	assertParseLayoutFail(t, `date.ParseLayout: "Oct 2026": invalid layout: missing year, month or day`, "%b %Y", "Oct 2026")
+	assertParseLayoutFail(t, `date.ParseLayout: "18.10.2026": invalid layout: unsupported verb "%-Y"`, "%d.%m.%-Y", "18.10.2026")
+}
+
+func Test_LayoutFormatter(t *testing.T) {
+	defer func() {
+		Formatter = DefaultFormatter
+	}()
+	Formatter = LayoutFormatter("%d.%m.%Y")
+	b, err := New(2026, October, 18).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "18.10.2026", string(b))
+}
+
+func Test_LayoutParser(t *testing.T) {
+	defer func() {
+		Parser = DefaultParser[[]byte]
+	}()
+	Parser = LayoutParser("%m/%d/%Y")
+	d := Date{}
+	require.NoError(t, d.UnmarshalText([]byte("10/18/2026")))
+	assert.Equal(t, New(2026, October, 18), d)
+	assert.EqualError(t, d.UnmarshalText([]byte("2026-10-18")), `date.Date.UnmarshalText: date.LayoutParser: "2026-10-18": invalid date`)
+}
+
+func ExampleParseLayout() {
+	d, err := ParseLayout("%-d. %-m. %Y", "18. 10. 2026")
+	if err != nil {
+		panic(err)
+	}
+	b, _ := FormatLayout(nil, d, "%A, %B %-d, %Y")
+	fmt.Println(d)
+	fmt.Println(string(b))
+
+	// Output:
+	// 2026-10-18
+	// Sunday, October 18, 2026
+}
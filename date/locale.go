@@ -0,0 +1,207 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.lstv.dev/util/constraint"
+)
+
+// Locale represents names of months and weekdays in one language.
+// It is used by layout functions (see FormatLayout) and by FormatLong.
+type Locale struct {
+	// Tag is language tag used by RegisterLocale and LookupLocale, e.g. "cs".
+	Tag string
+
+	// Months are month names in nominative case (from January to December), e.g. "říjen".
+	Months [12]string
+
+	// MonthsGenitive are month names in genitive case used together with day of the month, e.g. "18. října".
+	// Empty names are replaced by Months.
+	MonthsGenitive [12]string
+
+	// MonthsShort are abbreviated month names.
+	MonthsShort [12]string
+
+	// Weekdays are weekday names (from Sunday to Saturday).
+	Weekdays [7]string
+
+	// WeekdaysShort are abbreviated weekday names (from Sunday to Saturday).
+	WeekdaysShort [7]string
+
+	// LongLayout is layout used by FormatLong, e.g. "%-d. %B %Y".
+	LongLayout string
+}
+
+var (
+	// English locale ("en").
+	English = &Locale{
+		Tag: "en",
+		Months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		MonthsShort: [12]string{
+			"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+		},
+		Weekdays: [7]string{
+			"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+		},
+		WeekdaysShort: [7]string{
+			"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat",
+		},
+		LongLayout: "%A, %-d %B %Y",
+	}
+
+	// Czech locale ("cs").
+	Czech = &Locale{
+		Tag: "cs",
+		Months: [12]string{
+			"leden", "únor", "březen", "duben", "květen", "červen",
+			"červenec", "srpen", "září", "říjen", "listopad", "prosinec",
+		},
+		MonthsGenitive: [12]string{
+			"ledna", "února", "března", "dubna", "května", "června",
+			"července", "srpna", "září", "října", "listopadu", "prosince",
+		},
+		MonthsShort: [12]string{
+			"led", "úno", "bře", "dub", "kvě", "čvn",
+			"čvc", "srp", "zář", "říj", "lis", "pro",
+		},
+		Weekdays: [7]string{
+			"neděle", "pondělí", "úterý", "středa", "čtvrtek", "pátek", "sobota",
+		},
+		WeekdaysShort: [7]string{
+			"ne", "po", "út", "st", "čt", "pá", "so",
+		},
+		LongLayout: "%-d. %B %Y",
+	}
+
+	// Slovak locale ("sk").
+	Slovak = &Locale{
+		Tag: "sk",
+		Months: [12]string{
+			"január", "február", "marec", "apríl", "máj", "jún",
+			"júl", "august", "september", "október", "november", "december",
+		},
+		MonthsGenitive: [12]string{
+			"januára", "februára", "marca", "apríla", "mája", "júna",
+			"júla", "augusta", "septembra", "októbra", "novembra", "decembra",
+		},
+		MonthsShort: [12]string{
+			"jan", "feb", "mar", "apr", "máj", "jún",
+			"júl", "aug", "sep", "okt", "nov", "dec",
+		},
+		Weekdays: [7]string{
+			"nedeľa", "pondelok", "utorok", "streda", "štvrtok", "piatok", "sobota",
+		},
+		WeekdaysShort: [7]string{
+			"ne", "po", "ut", "st", "št", "pi", "so",
+		},
+		LongLayout: "%-d. %B %Y",
+	}
+
+	// German locale ("de").
+	German = &Locale{
+		Tag: "de",
+		Months: [12]string{
+			"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember",
+		},
+		MonthsShort: [12]string{
+			"Jan", "Feb", "Mär", "Apr", "Mai", "Jun",
+			"Jul", "Aug", "Sep", "Okt", "Nov", "Dez",
+		},
+		Weekdays: [7]string{
+			"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag",
+		},
+		WeekdaysShort: [7]string{
+			"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa",
+		},
+		LongLayout: "%-d. %B %Y",
+	}
+
+	// DefaultLocale is used by FormatLayout, ParseLayout, LayoutFormatter, LayoutParser and FormatLong.
+	DefaultLocale = English
+
+	locales   = map[string]*Locale{}
+	localesMu sync.RWMutex
+)
+
+func init() {
+	for _, l := range []*Locale{English, Czech, Slovak, German} {
+		RegisterLocale(l)
+	}
+}
+
+// RegisterLocale adds locale to registry under its Tag.
+// Already registered locale with the same Tag is replaced.
+// It is safe for concurrent use.
+func RegisterLocale(l *Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[strings.ToLower(l.Tag)] = l
+}
+
+// LookupLocale returns registered locale by language tag (case-insensitive).
+// If tag has region (e.g. "cs-CZ" or "cs_CZ") and is not registered, language only is used (e.g. "cs").
+// Registered are "en", "cs", "sk" and "de" by default.
+// It is safe for concurrent use.
+func LookupLocale(tag string) (l *Locale, ok bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	tag = strings.ToLower(tag)
+	if l, ok = locales[tag]; ok {
+		return l, true
+	}
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		l, ok = locales[tag[:i]]
+	}
+	return l, ok
+}
+
+// Formatter returns function which can be used as Formatter.
+// It is the same as LayoutFormatter, but uses this locale instead of DefaultLocale.
+func (l *Locale) Formatter(layout string) func(buf []byte, d Date, f Format) ([]byte, error) {
+	return func(buf []byte, d Date, _ Format) ([]byte, error) {
+		return formatLayout(buf, d, layout, l)
+	}
+}
+
+// Parser returns function which can be used as Parser.
+// It is the same as LayoutParser, but uses this locale instead of DefaultLocale.
+func (l *Locale) Parser(layout string) func(input []byte, r Rule) (Date, error) {
+	return func(input []byte, _ Rule) (Date, error) {
+		return parseLayout("Locale.Parser", layout, input, l)
+	}
+}
+
+// FormatLayoutIn is the same as FormatLayout, but uses passed locale instead of DefaultLocale.
+func FormatLayoutIn(buf []byte, d Date, layout string, l *Locale) ([]byte, error) {
+	b, err := formatLayout(buf, d, layout, l)
+	if err != nil {
+		return nil, fmt.Errorf("date.FormatLayoutIn: %w", err)
+	}
+	return b, nil
+}
+
+// ParseLayoutIn is the same as ParseLayout, but uses passed locale instead of DefaultLocale.
+func ParseLayoutIn[T constraint.ParserInput](layout string, input T, l *Locale) (Date, error) {
+	return parseLayout("ParseLayoutIn", layout, input, l)
+}
+
+// month returns month name, genitive is used if present and requested.
+func (l *Locale) month(m Month, genitive bool) string {
+	if genitive {
+		if s := l.MonthsGenitive[m-1]; s != "" {
+			return s
+		}
+	}
+	return l.Months[m-1]
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LookupLocale(t *testing.T) {
+	for tag, expected := range map[string]*Locale{
+		"en":    English,
+		"cs":    Czech,
+		"cs-CZ": Czech,
+		"SK_sk": Slovak,
+		"de-AT": German,
+	} {
+		l, ok := LookupLocale(tag)
+		assert.True(t, ok, tag)
+		assert.Same(t, expected, l, tag)
+	}
+	l, ok := LookupLocale("pl")
+	assert.False(t, ok)
+	assert.Nil(t, l)
+
+	pl := &Locale{Tag: "pl"}
+	RegisterLocale(pl)
+	defer func() {
+		localesMu.Lock()
+		delete(locales, "pl")
+		localesMu.Unlock()
+	}()
+	l, ok = LookupLocale("pl-PL")
+	assert.True(t, ok)
+	assert.Same(t, pl, l)
+}
+
+func Test_FormatLayoutIn(t *testing.T) {
+	d := New(2026, October, 18)
+	for _, c := range []struct {
+		locale   *Locale
+		layout   string
+		expected string
+	}{
+		{English, "%A, %-d %B %Y", "Sunday, 18 October 2026"},
+		{English, "%a %b %OB", "Sun Oct October"},
+		{Czech, "%A %-d. %B %Y", "neděle 18. října 2026"},
+		{Czech, "%OB %Y", "říjen 2026"},
+		{Czech, "%a %-d. %b", "ne 18. říj"},
+		{Slovak, "%A %-d. %B %Y", "nedeľa 18. októbra 2026"},
+		{Slovak, "%OB", "október"},
+		{German, "%A, %-d. %B %Y", "Sonntag, 18. Oktober 2026"},
+		{German, "%a %OB", "So Oktober"},
+	} {
+		b, err := FormatLayoutIn(nil, d, c.layout, c.locale)
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, string(b), c.layout)
+	}
+	_, err := FormatLayoutIn(nil, d, "%Od", Czech)
+	assert.EqualError(t, err, `date.FormatLayoutIn: invalid layout: unsupported verb "%Od"`)
+}
+
+func Test_ParseLayoutIn(t *testing.T) {
+	expected := New(2026, October, 18)
+	for _, c := range []struct {
+		locale *Locale
+		layout string
+		input  string
+	}{
+		{English, "%A, %-d %B %Y", "Sunday, 18 October 2026"},
+		{Czech, "%-d. %B %Y", "18. října 2026"},
+		{Czech, "%-d. %B %Y", "18. říjen 2026"},
+		{Czech, "%-d. %OB %Y", "18. října 2026"},
+		{Czech, "%A %-d. %b %Y", "Neděle 18. říj 2026"},
+		{Slovak, "%-d. %B %Y", "18. októbra 2026"},
+		{German, "%-d. %B %Y", "18. oktober 2026"},
+	} {
+		d, err := ParseLayoutIn(c.layout, c.input, c.locale)
+		require.NoError(t, err, c.input)
+		assert.Equal(t, expected, d, c.input)
+	}
+	// longest name must be used, "červen" (June) is prefix of "červenec" (July)
+	d, err := ParseLayoutIn("%OB %Y %-d", "červenec 2026 1", Czech)
+	require.NoError(t, err)
+	assert.Equal(t, New(2026, July, 1), d)
+
+	d, err = ParseLayoutIn("%-d. %B %Y", "18. October 2026", Czech)
+	assert.Zero(t, d)
+	assert.EqualError(t, err, `date.ParseLayoutIn: "18. October 2026": invalid date`)
+}
+
+func Test_Locale_Formatter_Parser(t *testing.T) {
+	defer func() {
+		Formatter = DefaultFormatter
+		Parser = DefaultParser[[]byte]
+	}()
+	Formatter = Czech.Formatter("%-d. %B %Y")
+	Parser = Czech.Parser("%-d. %B %Y")
+	b, err := New(2026, October, 18).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "18. října 2026", string(b))
+	d := Date{}
+	require.NoError(t, d.UnmarshalText(b))
+	assert.Equal(t, New(2026, October, 18), d)
+	assert.EqualError(t, d.UnmarshalText([]byte("x")), `date.Date.UnmarshalText: date.Locale.Parser: "x": invalid date`)
+}
+
+func Test_FormatLong(t *testing.T) {
+	defer func() {
+		Formatter = DefaultFormatter
+		DefaultLocale = English
+	}()
+	Formatter = DefaultFormatter
+	d := New(2026, October, 18)
+	assert.Equal(t, "Sunday, 18 October 2026", fmt.Sprintf("%L", d))
+	DefaultLocale = Czech
+	assert.Equal(t, "18. října 2026", fmt.Sprintf("%L", d))
+	b, err := DefaultFormatter(nil, d, FormatLong|FormatWeek)
+	require.NoError(t, err)
+	assert.Equal(t, "18. října 2026", string(b))
+}
+
+func ExampleFormatLayoutIn() {
+	d := New(2026, October, 18)
+	for _, tag := range []string{"en", "cs", "sk", "de"} {
+		l, _ := LookupLocale(tag)
+		b, _ := FormatLayoutIn(nil, d, l.LongLayout, l)
+		fmt.Println(string(b))
+	}
+
+	// Output:
+	// Sunday, 18 October 2026
+	// 18. října 2026
+	// 18. októbra 2026
+	// 18. Oktober 2026
+}
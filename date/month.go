@@ -48,3 +48,38 @@ const (
 	// December is equivalent to time.December.
 	December = time.December
 )
+
+// MonthPolicy allows configuring Date.AddMonths and Date.AddYears behavior
+// if day of month does not exist in resulting month (e.g. 2026-01-31 plus one month).
+// Available policies are:
+//   MonthOverflow
+//   MonthClamp
+//   MonthReject
+type MonthPolicy int
+
+const (
+	// MonthOverflow moves overflowing days to the next month, e.g. 2026-01-31 plus one month is 2026-03-03.
+	// It is the same behavior as Date.Add and time.Time.AddDate have.
+	MonthOverflow = MonthPolicy(iota)
+
+	// MonthClamp uses the last day of resulting month, e.g. 2026-01-31 plus one month is 2026-02-28.
+	MonthClamp
+
+	// MonthReject returns error wrapping ErrDayOutOfMonth.
+	MonthReject
+)
+
+// LeapDayPolicy allows configuring Date.Anniversary, Date.YearsBetween and Date.MonthsBetween behavior
+// if day of month does not exist in anniversary month, typically February 29 in common year.
+// Available policies are:
+//   LeapDayFebruary28
+//   LeapDayMarch1
+type LeapDayPolicy int
+
+const (
+	// LeapDayFebruary28 uses the last day of month, e.g. anniversary of 2024-02-29 in 2025 is 2025-02-28.
+	LeapDayFebruary28 = LeapDayPolicy(iota)
+
+	// LeapDayMarch1 uses the first day of next month, e.g. anniversary of 2024-02-29 in 2025 is 2025-03-01.
+	LeapDayMarch1
+)
@@ -0,0 +1,54 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"sync"
+	"time"
+)
+
+// Now returns current time and it is used by Today and TodayIn.
+// It can be replaced, e.g. by FakeNow.Now in tests.
+var Now = time.Now
+
+// FakeNow is manually controlled time provider for tests.
+// Its Now method can be assigned to Now variable.
+// It is safe for concurrent use.
+type FakeNow struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+// NewFakeNow creates fake time provider returning passed time.
+func NewFakeNow(t time.Time) *FakeNow {
+	return &FakeNow{t: t}
+}
+
+// Now returns current fake time.
+func (f *FakeNow) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.t
+}
+
+// Set fake time to passed time.
+func (f *FakeNow) Set(t time.Time) {
+	f.mu.Lock()
+	f.t = t
+	f.mu.Unlock()
+}
+
+// Add passed duration to fake time.
+func (f *FakeNow) Add(d time.Duration) {
+	f.mu.Lock()
+	f.t = f.t.Add(d)
+	f.mu.Unlock()
+}
+
+// TodayIn returns actual date in passed location.
+// For example, it returns the next day in Europe/Prague for 2026-10-18T23:30Z.
+func TodayIn(loc *time.Location) Date {
+	return FromTime(Now().In(loc))
+}
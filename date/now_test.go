@@ -0,0 +1,51 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FakeNow(t *testing.T) {
+	now := time.Date(2026, October, 18, 20, 45, 0, 0, time.UTC)
+	f := NewFakeNow(now)
+	assert.Equal(t, now, f.Now())
+	f.Add(time.Hour)
+	assert.Equal(t, now.Add(time.Hour), f.Now())
+	f.Set(now)
+	assert.Equal(t, now, f.Now())
+}
+
+func Test_TodayIn(t *testing.T) {
+	defer func() {
+		Now = time.Now
+	}()
+	prague, err := time.LoadLocation("Europe/Prague")
+	require.NoError(t, err)
+	f := NewFakeNow(time.Date(2026, October, 18, 21, 30, 0, 0, time.UTC))
+	Now = f.Now
+	assert.Equal(t, New(2026, October, 18), Today())
+	assert.Equal(t, New(2026, October, 18), TodayIn(time.UTC))
+	assert.Equal(t, New(2026, October, 18), TodayIn(prague))
+	f.Add(time.Hour)
+	assert.Equal(t, New(2026, October, 18), Today())
+	assert.Equal(t, New(2026, October, 18), TodayIn(time.UTC))
+	assert.Equal(t, New(2026, October, 19), TodayIn(prague))
+	assert.Equal(t, New(2026, October, 18), TodayIn(time.FixedZone("-10", -10*60*60)))
+}
+
+func Test_FromTime_location(t *testing.T) {
+	prague, err := time.LoadLocation("Europe/Prague")
+	require.NoError(t, err)
+	u := time.Date(2026, October, 18, 23, 30, 0, 0, time.UTC)
+	assert.Equal(t, New(2026, October, 18), FromTime(u))
+	assert.Equal(t, New(2026, October, 19), FromTime(u.In(prague)))
+	assert.True(t, FromTime(time.Time{}.In(time.FixedZone("-1", -60*60))).IsZero())
+}
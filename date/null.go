@@ -0,0 +1,121 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// NullDate represents Date that may be null.
+// It can be used as scan destination and query argument similar to sql.NullTime,
+// and it is marshaled as JSON null or empty text if Valid is false.
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// NewNullDate creates valid NullDate with passed date.
+func NewNullDate(d Date) NullDate {
+	return NullDate{Date: d, Valid: true}
+}
+
+// Ptr returns pointer to date copy or nil if NullDate is not valid.
+func (n NullDate) Ptr() *Date {
+	if !n.Valid {
+		return nil
+	}
+	d := n.Date
+	return &d
+}
+
+// Scan is support for database/sql package.
+// NULL value sets Valid to false, other values are scanned by Date.Scan.
+func (n *NullDate) Scan(src any) error {
+	if src == nil {
+		*n = NullDate{}
+		return nil
+	}
+	d := Date{}
+	if err := d.Scan(src); err != nil {
+		return fmt.Errorf("date.NullDate.Scan: %w", err)
+	}
+	*n = NullDate{Date: d, Valid: true}
+	return nil
+}
+
+// Value is support for database/sql package.
+// It returns nil if NullDate is not valid, otherwise the same value as Date.Value.
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.Value()
+}
+
+// MarshalText converts date to text with Formatter.
+// It returns empty text if NullDate is not valid.
+func (n NullDate) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	b, err := n.Date.MarshalText()
+	if err != nil {
+		return nil, fmt.Errorf("date.NullDate.MarshalText: %w", err)
+	}
+	return b, nil
+}
+
+// UnmarshalText using global Parser function.
+// Empty text sets Valid to false.
+func (n *NullDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*n = NullDate{}
+		return nil
+	}
+	d := Date{}
+	if err := d.UnmarshalText(data); err != nil {
+		return fmt.Errorf("date.NullDate.UnmarshalText: %w", err)
+	}
+	*n = NullDate{Date: d, Valid: true}
+	return nil
+}
+
+// MarshalJSON converts date to JSON the same way as Date does.
+// It returns JSON null if NullDate is not valid.
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	b, err := json.Marshal(n.Date)
+	if err != nil {
+		return nil, fmt.Errorf("date.NullDate.MarshalJSON: %w", err)
+	}
+	return b, nil
+}
+
+// UnmarshalJSON parses date from JSON the same way as Date does.
+// JSON null sets Valid to false.
+func (n *NullDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NullDate{}
+		return nil
+	}
+	d := Date{}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return fmt.Errorf("date.NullDate.UnmarshalJSON: %w", err)
+	}
+	*n = NullDate{Date: d, Valid: true}
+	return nil
+}
+
+// String returns date text form or "NULL" if NullDate is not valid.
+func (n NullDate) String() string {
+	if !n.Valid {
+		return "NULL"
+	}
+	return n.Date.String()
+}
@@ -0,0 +1,144 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.lstv.dev/util/test"
+)
+
+func Test_NewNullDate(t *testing.T) {
+	n := NewNullDate(Date{})
+	assert.True(t, n.Valid)
+	assert.Equal(t, Date{}, n.Date)
+}
+
+func Test_NullDate_Ptr(t *testing.T) {
+	assert.Nil(t, NullDate{}.Ptr())
+	d := NewNullDate(New(2026, October, 18)).Ptr()
+	require.NotNil(t, d)
+	assert.Equal(t, New(2026, October, 18), *d)
+}
+
+func Test_NullDate_Scan(t *testing.T) {
+	n := NewNullDate(New(2026, October, 18))
+	require.NoError(t, n.Scan(nil))
+	assert.Equal(t, NullDate{}, n)
+	require.NoError(t, n.Scan(time.Date(2002, August, 7, 14, 12, 55, 7, time.UTC)))
+	assert.Equal(t, NewNullDate(New(2002, August, 7)), n)
+	err := n.Scan(true)
+	assert.True(t, errors.Is(err, ErrInvalidType))
+	assert.EqualError(t, err, "date.NullDate.Scan: date.Date.Scan: invalid type: expected time.Time, string, []byte or integer instead of bool")
+	assert.Equal(t, NewNullDate(New(2002, August, 7)), n)
+}
+
+func Test_NullDate_Value(t *testing.T) {
+	v, err := NullDate{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	v, err = NewNullDate(New(2002, August, 7)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2002, August, 7, 0, 0, 0, 0, time.UTC), v)
+}
+
+func Test_NullDate_MarshalText(t *testing.T) {
+	Formatter = DefaultFormatter
+	test.MarshalText(t, []test.CaseText[NullDate]{
+		{ // 0
+			Data:  ``,
+			Value: NullDate{},
+		},
+		{ // 1
+			Data:  `0001-01-01`,
+			Value: NewNullDate(Date{}),
+		},
+		{ // 2
+			Data:  `2002-08-07`,
+			Value: NewNullDate(New(2002, August, 7)),
+		},
+	})
+}
+
+func Test_NullDate_UnmarshalText(t *testing.T) {
+	test.UnmarshalText(t, []test.CaseText[NullDate]{
+		{ // 0
+			Data:  ``,
+			Value: NullDate{},
+		},
+		{ // 1
+			Data:  `0001-01-01`,
+			Value: NewNullDate(Date{}),
+		},
+		{ // 2
+			Error: test.Error("date.NullDate.UnmarshalText: date.Date.UnmarshalText: date.DefaultParser: \"2002\": invalid date"),
+			Data:  `2002`,
+		},
+	}, nil)
+}
+
+func Test_NullDate_MarshalJSON(t *testing.T) {
+	Formatter = DefaultFormatter
+	test.MarshalJSON(t, []test.CaseJSON[NullDate]{
+		{ // 0
+			Data:  `null`,
+			Value: NullDate{},
+		},
+		{ // 1
+			Data:  `"0001-01-01"`,
+			Value: NewNullDate(Date{}),
+		},
+		{ // 2
+			Data:  `"2002-08-07"`,
+			Value: NewNullDate(New(2002, August, 7)),
+		},
+	})
+}
+
+func Test_NullDate_UnmarshalJSON(t *testing.T) {
+	test.UnmarshalJSON(t, []test.CaseJSON[NullDate]{
+		{ // 0
+			Data:  `null`,
+			Value: NullDate{},
+		},
+		{ // 1
+			Data:  `"2002-08-07"`,
+			Value: NewNullDate(New(2002, August, 7)),
+		},
+		{ // 2
+			Error: test.Error("date.NullDate.UnmarshalJSON: date.Date.UnmarshalJSON: date.DefaultParser: \"2002\": invalid date"),
+			Data:  `"2002"`,
+		},
+		{ // 3
+			Error: test.Error("date.NullDate.UnmarshalJSON: date.Date.UnmarshalJSON: unix form disabled"),
+			Data:  `2002`,
+		},
+	}, nil)
+}
+
+func Test_NullDate_json_field(t *testing.T) {
+	v := struct {
+		A NullDate `json:"a"`
+		B NullDate `json:"b"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(`{"a":"2026-10-18","b":null}`), &v))
+	assert.Equal(t, NewNullDate(New(2026, October, 18)), v.A)
+	assert.False(t, v.B.Valid)
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a":"2026-10-18","b":null}`, string(b))
+}
+
+func Test_NullDate_String(t *testing.T) {
+	Formatter = DefaultFormatter
+	assert.Equal(t, "NULL", NullDate{}.String())
+	assert.Equal(t, "2002-08-07", NewNullDate(New(2002, August, 7)).String())
+}
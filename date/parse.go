@@ -6,6 +6,7 @@ package date
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 
@@ -17,27 +18,85 @@ var (
 	// Set 0 to disable this setting.
 	// ErrInputTooLong is wrapped and used if limit is exceeded.
 	// Note: For year > 9999, increment MaxInputLength to > 10.
+	// If RuleEnableExpanded is present or input starts with minus sign, limit is increased by 7 (sign and up to 10-digit year).
 	MaxInputLength = 10
 
-	// Parser is used by Date.UnmarshalText function.
+	// Parser is used by Date.UnmarshalText, Date.UnmarshalJSON (string form) and Date.Scan functions.
 	Parser = DefaultParser[[]byte]
 
-	pattern = regexp.MustCompile(`^([0-9]{4,9})-?(1[0-2]|0[0-9])-?(3[01]|[0-2][0-9])$`)
+	// DefaultRule is used by Date.UnmarshalText, Date.UnmarshalJSON and Date.Scan converting functions,
+	// e.g. set RuleEnableRelative to accept relative date expressions or RuleEnableJSONObjectForm to accept JSON object form.
+	DefaultRule = Rule(0)
+
+	pattern        = regexp.MustCompile(`^([+-][0-9]{4,10}|[0-9]{4,9})-?(1[0-2]|0[0-9])-?(3[01]|[0-2][0-9])$`)
+	patternWeek    = regexp.MustCompile(`^([+-][0-9]{4,10}|[0-9]{4,9})(-?)W(5[0-3]|[0-4][0-9])(-?)([1-7])$`)
+	patternOrdinal = regexp.MustCompile(`^([+-][0-9]{4,10}|[0-9]{4,9})(-?)(36[0-6]|3[0-5][0-9]|[0-2][0-9]{2})$`)
 )
 
 type (
 	// Rule allows configuring Parser behavior.
 	// Available rules are:
 	//   RuleDisableBasic
+	//   RuleDisableWeek
+	//   RuleDisableOrdinal
+	//   RuleEnableExpanded
+	//   RuleEnableRelative
+	//   RuleEnableJSONObjectForm
+	//   RuleEnableJSONUnixForm
+	//   RuleEnableJSONUnixMilliForm
+	//   RuleEnableJSONNull
 	Rule int
 )
 
 const (
-	// RuleDisableBasic disallow basic format (i.e. YYYYMMDD).
+	// RuleDisableBasic disallow basic format (i.e. YYYYMMDD, YYYYWwwD and YYYYDDD).
 	RuleDisableBasic = Rule(1 << iota)
+
+	// RuleDisableWeek disallow ISO 8601 week date format (i.e. YYYY-Www-D and YYYYWwwD).
+	RuleDisableWeek
+
+	// RuleDisableOrdinal disallow ISO 8601 ordinal date format (i.e. YYYY-DDD and YYYYDDD).
+	RuleDisableOrdinal
+
+	// RuleEnableExpanded allow ISO 8601 expanded year representation with plus sign (i.e. +YYYYY-MM-DD), e.g. +12026-01-01.
+	// Year can have from 4 to 10 digits and it must be in range of int32.
+	// It can be combined with all other formats except basic ordinal date, which would be ambiguous.
+	// Years with minus sign (e.g. -00044-03-15) are unambiguous and accepted even without this rule,
+	// so negative dates formatted by DefaultFormatter can be always parsed back.
+	RuleEnableExpanded
+
+	// RuleEnableRelative allow relative date expressions resolved against Today, e.g. "yesterday" or "+3d".
+	// See ParseRelative for supported expressions.
+	RuleEnableRelative
+
+	// RuleEnableJSONObjectForm allows JSON object form at Date.UnmarshalJSON, e.g. {"year": 2026, "month": 10, "day": 18}.
+	// If not present, Date.UnmarshalJSON returns error if Date is presented as JSON object.
+	RuleEnableJSONObjectForm
+
+	// RuleEnableJSONUnixForm allows JSON number form at Date.UnmarshalJSON as Unix time in seconds, e.g. 1792281600.
+	// If neither RuleEnableJSONUnixForm nor RuleEnableJSONUnixMilliForm is present,
+	// Date.UnmarshalJSON returns error if Date is presented as JSON number.
+	RuleEnableJSONUnixForm
+
+	// RuleEnableJSONUnixMilliForm allows JSON number form at Date.UnmarshalJSON as Unix time in milliseconds, e.g. 1792281600000.
+	// It takes precedence over RuleEnableJSONUnixForm.
+	RuleEnableJSONUnixMilliForm
+
+	// RuleEnableJSONNull makes Date.UnmarshalJSON to set zero date for JSON null.
+	// If not present, JSON null is ignored as usual for encoding/json package.
+	RuleEnableJSONNull
 )
 
 // DefaultParser parse Date from input.
+// Accepted are ISO 8601 calendar date (YYYY-MM-DD), week date (YYYY-Www-D)
+// and ordinal date (YYYY-DDD) in extended and basic format.
+// Basic ordinal date (YYYYDDD) is accepted only with four-digit year,
+// because longer input would be ambiguous with basic calendar date.
+// Years with minus sign (ISO 8601 expanded representation) are always accepted,
+// years with plus sign are accepted only if RuleEnableExpanded is present.
+// Year 0 is 1 BC, year -1 is 2 BC and so on (astronomical year numbering).
+// Relative date expressions are accepted only if RuleEnableRelative is present.
+// JSON rules are ignored, they affect only Date.UnmarshalJSON.
 //
 // See also MaxInputLength.
 func DefaultParser[T constraint.ParserInput](input T, r Rule) (date Date, err error) {
@@ -47,24 +106,119 @@ func DefaultParser[T constraint.ParserInput](input T, r Rule) (date Date, err er
 	if l == 0 {
 		return Date{}, newParseError(funcName, b, nil)
 	}
-	if MaxInputLength != 0 && l > MaxInputLength {
+	if r&RuleEnableRelative != 0 {
+		if d, ok := parseRelative(b, Today()); ok {
+			return d, nil
+		}
+	}
+	if b[0] == '-' {
+		r |= RuleEnableExpanded
+	}
+	if b[0] == '+' && r&RuleEnableExpanded == 0 {
+		if max := maxInputLength(RuleEnableExpanded); max != 0 && l > max {
+			var t T
+			return Date{}, newParseError(funcName, t, ErrExpandedFormatDisabled)
+		}
+		return Date{}, newParseError(funcName, input, ErrExpandedFormatDisabled)
+	}
+	if max := maxInputLength(r); max != 0 && l > max {
 		// do not use input for "input too long" error
 		var t T
-		return Date{}, newParseError(funcName, t, fmt.Errorf("%w: %d > %d", ErrInputTooLong, l, MaxInputLength))
+		return Date{}, newParseError(funcName, t, fmt.Errorf("%w: %d > %d", ErrInputTooLong, l, max))
 	}
-	parts := pattern.FindSubmatch(b)
-	if len(parts) == 0 {
-		return Date{}, newParseError(funcName, input, nil)
+	if parts := pattern.FindSubmatch(b); len(parts) != 0 {
+		if sep2 := b[l-3] == '-'; sep2 || b[l-5] == '-' { // extended format
+			if !sep2 || b[l-6] != '-' { // disallow YYYY-MMDD and YYYYMM-YY formats
+				return Date{}, newParseError(funcName, input, nil)
+			}
+		} else if r&RuleDisableBasic != 0 {
+			return Date{}, newParseError(funcName, input, ErrBasicFormatDisabled)
+		}
+		year, ok := parseYearNumber(parts[1])
+		if !ok {
+			return Date{}, newParseError(funcName, input, nil)
+		}
+		month, _ := strconv.Atoi(string(parts[2]))
+		day, _ := strconv.Atoi(string(parts[3]))
+		return New(year, Month(month), day), nil
 	}
-	if sep2 := b[l-3] == '-'; sep2 || b[l-5] == '-' { // extended format
-		if !sep2 || b[l-6] != '-' { // disallow YYYY-MMDD and YYYYMM-YY formats
+	if parts := patternWeek.FindSubmatch(b); len(parts) != 0 {
+		if len(parts[2]) != len(parts[4]) { // disallow YYYY-WwwD and YYYYWww-D formats
+			return Date{}, newParseError(funcName, input, nil)
+		}
+		if r&RuleDisableWeek != 0 {
+			return Date{}, newParseError(funcName, input, ErrWeekFormatDisabled)
+		}
+		if len(parts[2]) == 0 && r&RuleDisableBasic != 0 {
+			return Date{}, newParseError(funcName, input, ErrBasicFormatDisabled)
+		}
+		year, ok := parseYearNumber(parts[1])
+		if !ok {
+			return Date{}, newParseError(funcName, input, nil)
+		}
+		week, _ := strconv.Atoi(string(parts[3]))
+		weekday, _ := strconv.Atoi(string(parts[5]))
+		if week == 0 || week > isoWeeks(year) {
 			return Date{}, newParseError(funcName, input, nil)
 		}
-	} else if r&RuleDisableBasic != 0 {
-		return Date{}, newParseError(funcName, input, ErrBasicFormatDisabled)
+		return NewISOWeek(year, week, Weekday(weekday%7)), nil
 	}
-	year, _ := strconv.Atoi(string(parts[1]))
-	month, _ := strconv.Atoi(string(parts[2]))
-	day, _ := strconv.Atoi(string(parts[3]))
-	return New(year, Month(month), day), nil
+	if parts := patternOrdinal.FindSubmatch(b); len(parts) != 0 {
+		if len(parts[2]) == 0 && len(parts[1]) != 4 { // disallow ambiguous basic format
+			return Date{}, newParseError(funcName, input, nil)
+		}
+		if r&RuleDisableOrdinal != 0 {
+			return Date{}, newParseError(funcName, input, ErrOrdinalFormatDisabled)
+		}
+		if len(parts[2]) == 0 && r&RuleDisableBasic != 0 {
+			return Date{}, newParseError(funcName, input, ErrBasicFormatDisabled)
+		}
+		year, ok := parseYearNumber(parts[1])
+		if !ok {
+			return Date{}, newParseError(funcName, input, nil)
+		}
+		day, _ := strconv.Atoi(string(parts[3]))
+		if day == 0 || day > yearDays(year) {
+			return Date{}, newParseError(funcName, input, nil)
+		}
+		return NewYearDay(year, day), nil
+	}
+	return Date{}, newParseError(funcName, input, nil)
+}
+
+// maxInputLength returns limit of DefaultParser input length for passed rules.
+func maxInputLength(r Rule) int {
+	if MaxInputLength != 0 && r&RuleEnableExpanded != 0 {
+		return MaxInputLength + 7
+	}
+	return MaxInputLength
+}
+
+// signedMaxInputLength returns limit of input length for input which can start with year with minus sign.
+func signedMaxInputLength(b []byte) int {
+	if len(b) != 0 && b[0] == '-' {
+		return maxInputLength(RuleEnableExpanded)
+	}
+	return MaxInputLength
+}
+
+// parseYearNumber parses year with optional sign and checks it is in range of Date.
+func parseYearNumber(b []byte) (int, bool) {
+	year, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || year-1 < math.MinInt32 || year-1 > math.MaxInt32 {
+		return 0, false
+	}
+	return int(year), true
+}
+
+// isoWeeks returns count of weeks in ISO 8601 week-numbering year (52 or 53).
+func isoWeeks(year int) int {
+	// December 28 is always in the last week of year
+	_, week := New(year, December, 28).ISOWeek()
+	return week
+}
+
+// yearDays returns count of days in year (365 or 366).
+func yearDays(year int) int {
+	return 337 + int(monthLength(int64(year), 2))
 }
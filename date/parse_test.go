@@ -5,6 +5,7 @@
 package date
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -38,3 +39,69 @@ func Test_DefaultParser(t *testing.T) {
 	MaxInputLength = 10
 	assertDefaultParserFail(t, `date.DefaultParser: input too long: 11 > 10`, `xxxxxxxxxxx`, RuleDisableBasic)
 }
+
+func Test_DefaultParser_expanded(t *testing.T) {
+	assertDefaultParser(t, New(2026, October, 18), `+02026-10-18`, RuleEnableExpanded)
+	assertDefaultParser(t, New(2026, October, 18), `+2026-10-18`, RuleEnableExpanded)
+	assertDefaultParser(t, New(12026, January, 1), `+120260101`, RuleEnableExpanded)
+	assertDefaultParser(t, New(0, December, 31), `+00000-12-31`, RuleEnableExpanded)
+	assertDefaultParser(t, New(0, December, 31), `-00000-12-31`, RuleEnableExpanded)
+	assertDefaultParser(t, New(-44, March, 15), `-00044-03-15`, RuleEnableExpanded)
+	assertDefaultParser(t, New(2026, October, 18), `+02026-W42-7`, RuleEnableExpanded)
+	assertDefaultParser(t, New(-4, December, 31), `-00004-366`, RuleEnableExpanded)
+	assertDefaultParser(t, Date{year: math.MaxInt32, month: 11, day: 30}, `+2147483648-12-31`, RuleEnableExpanded)
+	assertDefaultParser(t, Date{year: math.MinInt32}, `-2147483647-01-01`, RuleEnableExpanded)
+	assertDefaultParserFail(t, `date.DefaultParser: "+2147483649-01-01": invalid date`, `+2147483649-01-01`, RuleEnableExpanded)
+	assertDefaultParserFail(t, `date.DefaultParser: "-0000044366": invalid date`, `-0000044366`, RuleEnableExpanded)
+	assertDefaultParserFail(t, `date.DefaultParser: "+02026-10-18": expanded format disabled`, `+02026-10-18`, 0)
+	assertDefaultParser(t, New(-44, March, 15), `-00044-03-15`, 0)
+	assertDefaultParser(t, New(-4, December, 31), `-0004-366`, RuleDisableBasic)
+	assertDefaultParser(t, Date{year: math.MinInt32}, `-2147483647-01-01`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "-0000044366": invalid date`, `-0000044366`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: input too long: 18 > 17`, `-02147483647-01-01`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "+120260101": basic format disabled`, `+120260101`, RuleEnableExpanded|RuleDisableBasic)
+	assertDefaultParserFail(t, `date.DefaultParser: input too long: 18 > 17`, `+02147483648-12-31`, RuleEnableExpanded)
+	assertDefaultParserFail(t, `date.DefaultParser: "+12026-01-01": expanded format disabled`, `+12026-01-01`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: expanded format disabled`, `+0000000000000000000`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: input too long: 11 > 10`, `12026-01-01`, RuleDisableWeek)
+}
+
+func Test_DefaultParser_week(t *testing.T) {
+	assertDefaultParser(t, New(2026, October, 14), `2026-W42-3`, 0)
+	assertDefaultParser(t, New(2026, October, 18), `2026W427`, 0)
+	assertDefaultParser(t, New(2021, January, 1), `2020-W53-5`, 0)
+	assertDefaultParser(t, New(2008, December, 29), `2009-W01-1`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "2026-W423": invalid date`, `2026-W423`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "2026W42-3": invalid date`, `2026W42-3`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "2026-W00-1": invalid date`, `2026-W00-1`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "2021-W53-1": invalid date`, `2021-W53-1`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "2026-W42-8": invalid date`, `2026-W42-8`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "2026-W42-3": week format disabled`, `2026-W42-3`, RuleDisableWeek)
+	assertDefaultParserFail(t, `date.DefaultParser: "2026W423": basic format disabled`, `2026W423`, RuleDisableBasic)
+}
+
+func Test_DefaultParser_ordinal(t *testing.T) {
+	assertDefaultParser(t, New(2026, October, 18), `2026-291`, 0)
+	assertDefaultParser(t, New(2026, October, 18), `2026291`, 0)
+	assertDefaultParser(t, New(2020, December, 31), `2020-366`, 0)
+	assertDefaultParser(t, New(12026, January, 1), `12026-001`, 0)
+	assertDefaultParser(t, New(2002, October, 18), `20021018`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "2021-366": invalid date`, `2021-366`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "2021-000": invalid date`, `2021-000`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "20021318": invalid date`, `20021318`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "2026-291": ordinal format disabled`, `2026-291`, RuleDisableOrdinal)
+	assertDefaultParserFail(t, `date.DefaultParser: "2026291": basic format disabled`, `2026291`, RuleDisableBasic)
+}
+
+func Test_isoWeeks(t *testing.T) {
+	assert.Equal(t, 53, isoWeeks(2020))
+	assert.Equal(t, 52, isoWeeks(2021))
+	assert.Equal(t, 53, isoWeeks(2026))
+}
+
+func Test_yearDays(t *testing.T) {
+	assert.Equal(t, 366, yearDays(2020))
+	assert.Equal(t, 365, yearDays(2021))
+	assert.Equal(t, 365, yearDays(1900))
+	assert.Equal(t, 366, yearDays(2000))
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.lstv.dev/util/constraint"
+)
+
+// Period is calendar amount of time in years, months, weeks and days, e.g. "1 month and 10 days".
+// It is date part of ISO 8601 duration, text form is for example P1Y2M10D or P3W.
+// Components can be negative, zero value is valid and represents empty period.
+//
+// Period is not normalized, i.e. P14M is not the same as P1Y2M,
+// but adding of both to date gives the same result.
+type Period struct {
+	Years  int
+	Months int
+	Weeks  int
+	Days   int
+}
+
+// ParsePeriod parses ISO 8601 duration with date components only, i.e. PnYnMnWnD.
+// Components are optional, but at least one must be present and they must be in order Y, M, W and D.
+// Leading minus sign negates whole period (e.g. -P1M), single components can be also negative (e.g. P1M-1D).
+// Time components (e.g. PT1H) are not supported.
+// It returns ParseError wrapping ErrInvalidPeriod if input is invalid.
+func ParsePeriod[T constraint.ParserInput](input T) (Period, error) {
+	const funcName = "ParsePeriod"
+	b := []byte(input)
+	l := len(b)
+	if l == 0 {
+		return Period{}, newParseError(funcName, b, ErrInvalidPeriod)
+	}
+	i := 0
+	sign := 1
+	if b[0] == '-' || b[0] == '+' {
+		if b[0] == '-' {
+			sign = -1
+		}
+		i++
+	}
+	if i == l || b[i] != 'P' {
+		return Period{}, newParseError(funcName, input, ErrInvalidPeriod)
+	}
+	i++
+	p := Period{}
+	units := "YMWD"
+	for i < l {
+		start := i
+		if b[i] == '-' {
+			i++
+		}
+		for i < l && b[i] >= '0' && b[i] <= '9' {
+			i++
+		}
+		if i == l || b[i-1] < '0' || b[i-1] > '9' {
+			return Period{}, newParseError(funcName, input, ErrInvalidPeriod)
+		}
+		n, err := strconv.Atoi(string(b[start:i]))
+		if err != nil {
+			return Period{}, newParseError(funcName, input, ErrInvalidPeriod)
+		}
+		unit := 0
+		for unit < len(units) && units[unit] != b[i] {
+			unit++
+		}
+		if unit == len(units) {
+			return Period{}, newParseError(funcName, input, ErrInvalidPeriod)
+		}
+		switch units[unit] {
+		case 'Y':
+			p.Years = sign * n
+		case 'M':
+			p.Months = sign * n
+		case 'W':
+			p.Weeks = sign * n
+		case 'D':
+			p.Days = sign * n
+		}
+		units = units[unit+1:] // next component must follow in order
+		i++
+	}
+	if len(units) == 4 { // no component
+		return Period{}, newParseError(funcName, input, ErrInvalidPeriod)
+	}
+	return p, nil
+}
+
+// IsZero returns true if all components are zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns period with all components negated.
+func (p Period) Negate() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Weeks: -p.Weeks, Days: -p.Days}
+}
+
+// MarshalText converts period to ISO 8601 duration, e.g. P1Y2M10D.
+// Zero period is P0D, period with all components negative or zero is formatted with leading minus sign, e.g. -P1M.
+// It never returns error.
+func (p Period) MarshalText() ([]byte, error) {
+	return p.appendText(nil), nil
+}
+
+// UnmarshalText sets period from ISO 8601 duration using ParsePeriod.
+func (p *Period) UnmarshalText(data []byte) error {
+	v, err := ParsePeriod(data)
+	if err != nil {
+		return fmt.Errorf("date.Period.UnmarshalText: %w", err)
+	}
+	*p = v
+	return nil
+}
+
+// String returns ISO 8601 duration, e.g. P1Y2M10D.
+func (p Period) String() string {
+	return string(p.appendText(nil))
+}
+
+func (p Period) appendText(buf []byte) []byte {
+	if p.IsZero() {
+		return append(buf, "P0D"...)
+	}
+	if p.Years <= 0 && p.Months <= 0 && p.Weeks <= 0 && p.Days <= 0 {
+		buf = append(buf, '-')
+		p = p.Negate()
+	}
+	buf = append(buf, 'P')
+	for _, c := range []struct {
+		n    int
+		unit byte
+	}{
+		{p.Years, 'Y'},
+		{p.Months, 'M'},
+		{p.Weeks, 'W'},
+		{p.Days, 'D'},
+	} {
+		if c.n != 0 {
+			buf = append(strconv.AppendInt(buf, int64(c.n), 10), c.unit)
+		}
+	}
+	return buf
+}
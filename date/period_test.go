@@ -0,0 +1,165 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.lstv.dev/util/test"
+)
+
+func Test_Period(t *testing.T) {
+	assert.True(t, Period{}.IsZero())
+	assert.False(t, Period{Days: 1}.IsZero())
+	assert.Equal(t, Period{Years: -1, Months: 2, Weeks: -3, Days: 4}, Period{Years: 1, Months: -2, Weeks: 3, Days: -4}.Negate())
+	assert.Equal(t, "P1Y2M10D", Period{Years: 1, Months: 2, Days: 10}.String())
+}
+
+func Test_Period_MarshalText(t *testing.T) {
+	test.MarshalText(t, []test.CaseText[Period]{
+		{ // 0
+			Data:  `P0D`,
+			Value: Period{},
+		},
+		{ // 1
+			Data:  `P1Y2M10D`,
+			Value: Period{Years: 1, Months: 2, Days: 10},
+		},
+		{ // 2
+			Data:  `P3W`,
+			Value: Period{Weeks: 3},
+		},
+		{ // 3
+			Data:  `-P1M1D`,
+			Value: Period{Months: -1, Days: -1},
+		},
+		{ // 4
+			Data:  `P1M-1D`,
+			Value: Period{Months: 1, Days: -1},
+		},
+		{ // 5
+			Data:  `P14M`,
+			Value: Period{Months: 14},
+		},
+	})
+}
+
+func Test_Period_UnmarshalText(t *testing.T) {
+	test.UnmarshalText(t, []test.CaseText[Period]{
+		{ // 0
+			Data:  `P1Y2M10D`,
+			Value: Period{Years: 1, Months: 2, Days: 10},
+		},
+		{ // 1
+			Data:  `P3W`,
+			Value: Period{Weeks: 3},
+		},
+		{ // 2
+			Data:  `P0D`,
+			Value: Period{},
+		},
+		{ // 3
+			Data:  `-P1Y1W`,
+			Value: Period{Years: -1, Weeks: -1},
+		},
+		{ // 4
+			Data:  `+P1M-1D`,
+			Value: Period{Months: 1, Days: -1},
+		},
+		{ // 5
+			Data:  `-P-2D`,
+			Value: Period{Days: 2},
+		},
+		{ // 6
+			Error: test.Error(`date.Period.UnmarshalText: date.ParsePeriod: invalid period`),
+			Data:  ``,
+		},
+		{ // 7
+			Error: test.Error(`date.Period.UnmarshalText: date.ParsePeriod: "P": invalid period`),
+			Data:  `P`,
+		},
+		{ // 8
+			Error: test.Error(`date.Period.UnmarshalText: date.ParsePeriod: "P1D1M": invalid period`),
+			Data:  `P1D1M`,
+		},
+		{ // 9
+			Error: test.Error(`date.Period.UnmarshalText: date.ParsePeriod: "P1DT2H": invalid period`),
+			Data:  `P1DT2H`,
+		},
+		{ // 10
+			Error: test.Error(`date.Period.UnmarshalText: date.ParsePeriod: "P1Y1Y": invalid period`),
+			Data:  `P1Y1Y`,
+		},
+		{ // 11
+			Error: test.Error(`date.Period.UnmarshalText: date.ParsePeriod: "1D": invalid period`),
+			Data:  `1D`,
+		},
+		{ // 12
+			Error: test.Error(`date.Period.UnmarshalText: date.ParsePeriod: "P-D": invalid period`),
+			Data:  `P-D`,
+		},
+		{ // 13
+			Error: test.Error(`date.Period.UnmarshalText: date.ParsePeriod: "P1": invalid period`),
+			Data:  `P1`,
+		},
+		{ // 14
+			Error: test.Error(`date.Period.UnmarshalText: date.ParsePeriod: "P99999999999999999999D": invalid period`),
+			Data:  `P99999999999999999999D`,
+		},
+		{ // 15
+			Error: test.Error(`date.Period.UnmarshalText: date.ParsePeriod: "-": invalid period`),
+			Data:  `-`,
+		},
+	}, nil)
+}
+
+func Test_Period_json(t *testing.T) {
+	v := struct {
+		Contract Period `json:"contract"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(`{"contract":"P1M"}`), &v))
+	assert.Equal(t, Period{Months: 1}, v.Contract)
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.Equal(t, `{"contract":"P1M"}`, string(b))
+}
+
+func Test_Date_AddPeriod(t *testing.T) {
+	d := New(2026, October, 18)
+	assert.Equal(t, New(2027, December, 28), d.AddPeriod(Period{Years: 1, Months: 2, Days: 10}))
+	assert.Equal(t, New(2026, November, 8), d.AddPeriod(Period{Weeks: 3}))
+	assert.Equal(t, New(2026, September, 17), d.AddPeriod(Period{Months: -1, Days: -1}))
+	assert.Equal(t, New(2026, March, 3), New(2026, January, 31).AddPeriod(Period{Months: 1}))
+}
+
+func Test_Date_PeriodUntil(t *testing.T) {
+	for _, c := range []struct {
+		from, to Date
+		expected Period
+	}{
+		{New(2026, October, 18), New(2026, October, 18), Period{}},
+		{New(2026, October, 18), New(2027, December, 28), Period{Years: 1, Months: 2, Days: 10}},
+		{New(2027, December, 28), New(2026, October, 18), Period{Years: -1, Months: -2, Days: -10}},
+		{New(2026, October, 18), New(2026, November, 17), Period{Days: 30}},
+		{New(2026, October, 18), New(2026, November, 18), Period{Months: 1}},
+		{New(2026, January, 31), New(2026, March, 1), Period{Days: 29}},
+		{New(2026, January, 31), New(2026, March, 3), Period{Months: 1}},
+		{New(2026, March, 31), New(2026, February, 28), Period{Months: -1, Days: -3}},
+		{New(2024, February, 29), New(2025, February, 28), Period{Months: 11, Days: 30}},
+		{New(2024, February, 29), New(2028, February, 29), Period{Years: 4}},
+	} {
+		p := c.from.PeriodUntil(c.to)
+		assert.Equal(t, c.expected, p, "%s..%s", c.from, c.to)
+		assert.Equal(t, c.to, c.from.AddPeriod(p), "%s..%s", c.from, c.to)
+	}
+	from := New(2024, January, 31)
+	for to := New(2023, January, 1); to.Before(New(2025, December, 31)); to = to.Add(0, 0, 1) {
+		require.Equal(t, to, from.AddPeriod(from.PeriodUntil(to)), to)
+	}
+}
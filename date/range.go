@@ -0,0 +1,403 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"go.lstv.dev/util/constraint"
+)
+
+// rangeSeparator separates from date and to date in range text form.
+const rangeSeparator = ".."
+
+// SplitBy allows configuring Range.Split behavior.
+// Available values are:
+//   ByWeek
+//   ByMonth
+//   ByYear
+type SplitBy int
+
+const (
+	// ByWeek splits range by ISO 8601 weeks (from Monday to Sunday).
+	ByWeek = SplitBy(iota + 1)
+
+	// ByMonth splits range by calendar months.
+	ByMonth
+
+	// ByYear splits range by calendar years.
+	ByYear
+)
+
+// Range represents continuous range of dates.
+// Both from date and to date are including to range, but any of them can be unbounded.
+// Range is never empty, constructors return error instead.
+// Zero value is unbounded range containing all dates.
+type Range struct {
+	from    Date
+	to      Date
+	hasFrom bool
+	hasTo   bool
+}
+
+// NewRange creates closed range from date to date (both are including).
+// It can return wrapped ErrInvalidFromOrTo if from date is after to date.
+func NewRange(from, to Date) (Range, error) {
+	if from.After(to) {
+		return Range{}, fmt.Errorf("date.NewRange: %w: %s > %s", ErrInvalidFromOrTo, from, to)
+	}
+	return Range{from: from, to: to, hasFrom: true, hasTo: true}, nil
+}
+
+// NewRangeHalfOpen creates half-open range from date (including) to date (excluding).
+// It can return wrapped ErrInvalidFromOrTo if from date is not before to date.
+func NewRangeHalfOpen(from, to Date) (Range, error) {
+	if !from.Before(to) {
+		return Range{}, fmt.Errorf("date.NewRangeHalfOpen: %w: %s >= %s", ErrInvalidFromOrTo, from, to)
+	}
+	return Range{from: from, to: to.Add(0, 0, -1), hasFrom: true, hasTo: true}, nil
+}
+
+// RangeFrom creates range from date (including) without to date limit.
+func RangeFrom(from Date) Range {
+	return Range{from: from, hasFrom: true}
+}
+
+// RangeTo creates range to date (including) without from date limit.
+func RangeTo(to Date) Range {
+	return Range{to: to, hasTo: true}
+}
+
+// RangeUntil creates range to date (excluding) without from date limit.
+func RangeUntil(to Date) Range {
+	return Range{to: to.Add(0, 0, -1), hasTo: true}
+}
+
+// RangeFromFilter converts filter created by FilterFromTo (or Range.Filter) back to range.
+// It also converts YearMonth and Year to range of their days.
+// Returned ok is false if filter is not continuous range of dates.
+func RangeFromFilter(f Filter) (r Range, ok bool) {
+	switch v := f.(type) {
+	case Range:
+		return v, true
+	case *Range:
+		return *v, true
+	case filterNo:
+		return Range{}, true
+	case *filterDate:
+		return Range{from: v.date, to: v.date, hasFrom: true, hasTo: true}, true
+	case *filterFrom:
+		return RangeFrom(v.from), true
+	case *filterTo:
+		return RangeTo(v.to), true
+	case *filterFromTo:
+		return Range{from: v.from, to: v.to, hasFrom: true, hasTo: true}, true
+	case YearMonth:
+		return v.Range(), true
+	case Year:
+		return v.Range(), true
+	default:
+		return Range{}, false
+	}
+}
+
+// ParseRange parses range from text form.
+// Accepted forms are:
+//   2026-01-01..2026-02-01 (from date to date, both including)
+//   2026-01-01..           (from date without to date limit)
+//   ..2026-02-01           (to date without from date limit)
+//   ..                     (all dates)
+//   2026-01-01             (single date)
+// Dates are parsed using global Parser function with DefaultRule,
+// e.g. "yesterday..today" is accepted if DefaultRule contains RuleEnableRelative.
+// It can return *ParseError wrapping ErrInvalidFromOrTo.
+func ParseRange[T constraint.ParserInput](input T) (Range, error) {
+	return parseRange("ParseRange", input)
+}
+
+// From returns from date (including).
+// Returned ok is false if range has no from date limit.
+func (r Range) From() (from Date, ok bool) {
+	return r.from, r.hasFrom
+}
+
+// To returns to date (including).
+// Returned ok is false if range has no to date limit.
+func (r Range) To() (to Date, ok bool) {
+	return r.to, r.hasTo
+}
+
+// IsBounded returns true if range has both from date and to date limit.
+func (r Range) IsBounded() bool {
+	return r.hasFrom && r.hasTo
+}
+
+// Contains returns true if passed date is in range.
+// It allows using Range as Filter.
+func (r Range) Contains(date Date) bool {
+	return (!r.hasFrom || !r.from.After(date)) && (!r.hasTo || !r.to.Before(date))
+}
+
+// Len returns count of days in range.
+// If range is not bounded, Len returns -1.
+func (r Range) Len() int {
+	if !r.IsBounded() {
+		return -1
+	}
+	return r.to.DaysBetween(r.from) + 1
+}
+
+// Days calls yield for each date in range in ascending order.
+// If yield returns false, iteration stops.
+// If range has no from date limit, Days does nothing.
+// If range has no to date limit, iteration continues until yield returns false.
+func (r Range) Days(yield func(date Date) bool) {
+	if !r.hasFrom {
+		return
+	}
+	for d := r.from; !r.hasTo || !d.After(r.to); d = d.Add(0, 0, 1) {
+		if !yield(d) {
+			return
+		}
+	}
+}
+
+// Overlaps returns true if ranges have at least one common date.
+func (r Range) Overlaps(o Range) bool {
+	_, ok := r.Intersect(o)
+	return ok
+}
+
+// Intersect returns range of dates which are in both ranges.
+// Returned ok is false if ranges do not overlap.
+func (r Range) Intersect(o Range) (i Range, ok bool) {
+	i = r
+	if o.hasFrom && (!i.hasFrom || o.from.After(i.from)) {
+		i.from, i.hasFrom = o.from, true
+	}
+	if o.hasTo && (!i.hasTo || o.to.Before(i.to)) {
+		i.to, i.hasTo = o.to, true
+	}
+	if i.IsBounded() && i.from.After(i.to) {
+		return Range{}, false
+	}
+	return i, true
+}
+
+// Union returns range of dates which are in any of ranges.
+// Returned ok is false if ranges neither overlap nor are adjacent,
+// because result would not be continuous range.
+func (r Range) Union(o Range) (u Range, ok bool) {
+	if !r.Overlaps(o) && !r.adjacent(o) && !o.adjacent(r) {
+		return Range{}, false
+	}
+	u = r
+	if !o.hasFrom || (u.hasFrom && o.from.Before(u.from)) {
+		u.from, u.hasFrom = o.from, o.hasFrom
+	}
+	if !o.hasTo || (u.hasTo && o.to.After(u.to)) {
+		u.to, u.hasTo = o.to, o.hasTo
+	}
+	return u, true
+}
+
+// Split splits range to continuous ranges by passed period.
+// First and last returned ranges can be shorter than whole period.
+// If range is not bounded or SplitBy value is unknown, Split returns nil.
+func (r Range) Split(by SplitBy) []Range {
+	if !r.IsBounded() {
+		return nil
+	}
+	next := (func(Date) Date)(nil)
+	switch by {
+	case ByWeek:
+		next = func(d Date) Date {
+			return d.Add(0, 0, 8-isoWeekday(d.Weekday()))
+		}
+	case ByMonth:
+		next = func(d Date) Date {
+			return New(d.Year(), d.Month()+1, 1)
+		}
+	case ByYear:
+		next = func(d Date) Date {
+			return New(d.Year()+1, January, 1)
+		}
+	default:
+		return nil
+	}
+	ranges := []Range(nil)
+	for from := r.from; !from.After(r.to); {
+		n := next(from)
+		to := n.Add(0, 0, -1)
+		if to.After(r.to) {
+			to = r.to
+		}
+		ranges = append(ranges, Range{from: from, to: to, hasFrom: true, hasTo: true})
+		from = n
+	}
+	return ranges
+}
+
+// Filter converts range to the same filter as FilterFromTo returns.
+func (r Range) Filter() Filter {
+	from, to := (*Date)(nil), (*Date)(nil)
+	if r.hasFrom {
+		from = &r.from
+	}
+	if r.hasTo {
+		to = &r.to
+	}
+	// range is never empty, so error is impossible
+	f, _ := FilterFromTo(from, to)
+	return f
+}
+
+// MarshalText converts range to text form, e.g. "2026-01-01..2026-02-01".
+// Dates are formatted using global Formatter function.
+// See ParseRange for all forms.
+func (r Range) MarshalText() ([]byte, error) {
+	b, err := r.appendText(nil)
+	if err != nil {
+		return nil, fmt.Errorf("date.Range.MarshalText: %w", err)
+	}
+	return b, nil
+}
+
+// UnmarshalText parses range from text form using ParseRange.
+func (r *Range) UnmarshalText(data []byte) error {
+	v, err := parseRange("ParseRange", data)
+	if err != nil {
+		return fmt.Errorf("date.Range.UnmarshalText: %w", err)
+	}
+	*r = v
+	return nil
+}
+
+// MarshalJSON converts range to JSON string with text form.
+func (r Range) MarshalJSON() ([]byte, error) {
+	b, err := r.appendText(nil)
+	if err != nil {
+		return nil, fmt.Errorf("date.Range.MarshalJSON: %w", err)
+	}
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON parses range from JSON string with text form.
+// JSON null is ignored.
+func (r *Range) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	s := ""
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("date.Range.UnmarshalJSON: %w", err)
+	}
+	v, err := parseRange("ParseRange", s)
+	if err != nil {
+		return fmt.Errorf("date.Range.UnmarshalJSON: %w", err)
+	}
+	*r = v
+	return nil
+}
+
+// String returns range text form.
+// If Formatter returns error, dates are formatted same as DefaultFormatter does.
+func (r Range) String() string {
+	if r.IsBounded() && r.from.Equal(r.to) {
+		return r.from.String()
+	}
+	s := ""
+	if r.hasFrom {
+		s = r.from.String()
+	}
+	s += rangeSeparator
+	if r.hasTo {
+		s += r.to.String()
+	}
+	return s
+}
+
+// Describe returns the same description as filter returned by Range.Filter.
+func (r Range) Describe() string {
+	return Describe(r.Filter())
+}
+
+// adjacent returns true if passed range starts just after current one.
+func (r Range) adjacent(o Range) bool {
+	return r.hasTo && o.hasFrom && r.to.Add(0, 0, 1).Equal(o.from)
+}
+
+func (r Range) appendText(buf []byte) ([]byte, error) {
+	if r.IsBounded() && r.from.Equal(r.to) {
+		return Formatter(buf, r.from, 0)
+	}
+	err := error(nil)
+	if r.hasFrom {
+		if buf, err = Formatter(buf, r.from, 0); err != nil {
+			return nil, err
+		}
+	}
+	buf = append(buf, rangeSeparator...)
+	if r.hasTo {
+		if buf, err = Formatter(buf, r.to, 0); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// fromOrToError wraps error of parsing from or to date of range.
+// It is ErrInvalidFromOrTo and it keeps wrapped error available for errors.Is and errors.As.
+type fromOrToError struct {
+	err error
+}
+
+// Error returns string representation of error.
+func (e fromOrToError) Error() string {
+	return ErrInvalidFromOrTo.Error() + ": " + e.err.Error()
+}
+
+// Is returns true for ErrInvalidFromOrTo.
+func (e fromOrToError) Is(target error) bool {
+	return target == ErrInvalidFromOrTo
+}
+
+// Unwrap returns error of parsing from or to date.
+func (e fromOrToError) Unwrap() error {
+	return e.err
+}
+
+func parseRange[T constraint.ParserInput](funcName string, input T) (Range, error) {
+	b := []byte(input)
+	i := bytes.Index(b, []byte(rangeSeparator))
+	if i < 0 {
+		d, err := Parser(b, DefaultRule)
+		if err != nil {
+			return Range{}, newParseError(funcName, input, fromOrToError{err: err})
+		}
+		return Range{from: d, to: d, hasFrom: true, hasTo: true}, nil
+	}
+	r := Range{}
+	if from := b[:i]; len(from) != 0 {
+		d, err := Parser(from, DefaultRule)
+		if err != nil {
+			return Range{}, newParseError(funcName, input, fromOrToError{err: err})
+		}
+		r.from, r.hasFrom = d, true
+	}
+	if to := b[i+len(rangeSeparator):]; len(to) != 0 {
+		d, err := Parser(to, DefaultRule)
+		if err != nil {
+			return Range{}, newParseError(funcName, input, fromOrToError{err: err})
+		}
+		r.to, r.hasTo = d, true
+	}
+	if r.IsBounded() && r.from.After(r.to) {
+		return Range{}, newParseError(funcName, input, fmt.Errorf("%w: %s > %s", ErrInvalidFromOrTo, r.from, r.to))
+	}
+	return r, nil
+}
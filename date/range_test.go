@@ -0,0 +1,406 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.lstv.dev/util/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustRange(t *testing.T, from, to Date) Range {
+	t.Helper()
+	r, err := NewRange(from, to)
+	require.NoError(t, err)
+	return r
+}
+
+func Test_NewRange(t *testing.T) {
+	r, err := NewRange(New(2026, January, 1), New(2026, January, 31))
+	require.NoError(t, err)
+	assert.Equal(t, Range{from: New(2026, January, 1), to: New(2026, January, 31), hasFrom: true, hasTo: true}, r)
+	r, err = NewRange(New(2026, January, 1), New(2026, January, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, r.Len())
+	_, err = NewRange(New(2026, January, 2), New(2026, January, 1))
+	assert.EqualError(t, err, "date.NewRange: invalid from or to: 2026-01-02 > 2026-01-01")
+}
+
+func Test_NewRangeHalfOpen(t *testing.T) {
+	r, err := NewRangeHalfOpen(New(2026, January, 1), New(2026, February, 1))
+	require.NoError(t, err)
+	assert.Equal(t, mustRange(t, New(2026, January, 1), New(2026, January, 31)), r)
+	_, err = NewRangeHalfOpen(New(2026, January, 1), New(2026, January, 1))
+	assert.EqualError(t, err, "date.NewRangeHalfOpen: invalid from or to: 2026-01-01 >= 2026-01-01")
+}
+
+func Test_RangeFrom_RangeTo_RangeUntil(t *testing.T) {
+	r := RangeFrom(New(2026, January, 1))
+	assert.False(t, r.Contains(New(2025, December, 31)))
+	assert.True(t, r.Contains(New(2026, January, 1)))
+	assert.True(t, r.Contains(New(9999, January, 1)))
+	r = RangeTo(New(2026, January, 1))
+	assert.True(t, r.Contains(Date{}))
+	assert.True(t, r.Contains(New(2026, January, 1)))
+	assert.False(t, r.Contains(New(2026, January, 2)))
+	r = RangeUntil(New(2026, January, 1))
+	assert.True(t, r.Contains(New(2025, December, 31)))
+	assert.False(t, r.Contains(New(2026, January, 1)))
+	assert.True(t, Range{}.Contains(Date{}))
+}
+
+func Test_RangeFromFilter(t *testing.T) {
+	from, to := New(2026, January, 1), New(2026, January, 31)
+	ranges := []Range{
+		{},
+		RangeFrom(from),
+		RangeTo(to),
+		mustRange(t, from, from),
+		mustRange(t, from, to),
+	}
+	for i, r := range ranges {
+		v, ok := RangeFromFilter(r.Filter())
+		assert.True(t, ok, i)
+		assert.Equal(t, r, v, i)
+		v, ok = RangeFromFilter(r)
+		assert.True(t, ok, i)
+		assert.Equal(t, r, v, i)
+		v, ok = RangeFromFilter(&r)
+		assert.True(t, ok, i)
+		assert.Equal(t, r, v, i)
+	}
+	_, ok := RangeFromFilter(nil)
+	assert.False(t, ok)
+}
+
+func Test_Range_From_To(t *testing.T) {
+	from, ok := Range{}.From()
+	assert.False(t, ok)
+	assert.Zero(t, from)
+	to, ok := Range{}.To()
+	assert.False(t, ok)
+	assert.Zero(t, to)
+	r := mustRange(t, New(2026, January, 1), New(2026, January, 31))
+	from, ok = r.From()
+	assert.True(t, ok)
+	assert.Equal(t, New(2026, January, 1), from)
+	to, ok = r.To()
+	assert.True(t, ok)
+	assert.Equal(t, New(2026, January, 31), to)
+}
+
+func Test_Range_Len(t *testing.T) {
+	assert.Equal(t, -1, Range{}.Len())
+	assert.Equal(t, -1, RangeFrom(Date{}).Len())
+	assert.Equal(t, -1, RangeTo(Date{}).Len())
+	assert.Equal(t, 365, mustRange(t, New(2026, January, 1), New(2026, December, 31)).Len())
+}
+
+func Test_Range_Days(t *testing.T) {
+	days := []Date(nil)
+	collect := func(d Date) bool {
+		days = append(days, d)
+		return len(days) < 3
+	}
+	mustRange(t, New(2026, January, 30), New(2026, February, 1)).Days(collect)
+	assert.Equal(t, []Date{New(2026, January, 30), New(2026, January, 31), New(2026, February, 1)}, days)
+
+	days = nil
+	mustRange(t, New(2026, January, 30), New(2026, January, 30)).Days(collect)
+	assert.Equal(t, []Date{New(2026, January, 30)}, days)
+
+	days = nil
+	RangeFrom(New(2026, January, 30)).Days(collect)
+	assert.Equal(t, []Date{New(2026, January, 30), New(2026, January, 31), New(2026, February, 1)}, days)
+
+	days = nil
+	RangeTo(New(2026, January, 30)).Days(collect)
+	assert.Nil(t, days)
+}
+
+func Test_Range_Intersect(t *testing.T) {
+	jan := mustRange(t, New(2026, January, 1), New(2026, January, 31))
+	feb := mustRange(t, New(2026, February, 1), New(2026, February, 28))
+	mid := mustRange(t, New(2026, January, 15), New(2026, February, 15))
+
+	_, ok := jan.Intersect(feb)
+	assert.False(t, ok)
+	assert.False(t, jan.Overlaps(feb))
+
+	i, ok := jan.Intersect(mid)
+	assert.True(t, ok)
+	assert.True(t, jan.Overlaps(mid))
+	assert.Equal(t, mustRange(t, New(2026, January, 15), New(2026, January, 31)), i)
+
+	i, ok = Range{}.Intersect(jan)
+	assert.True(t, ok)
+	assert.Equal(t, jan, i)
+
+	i, ok = RangeFrom(New(2026, January, 10)).Intersect(RangeTo(New(2026, January, 20)))
+	assert.True(t, ok)
+	assert.Equal(t, mustRange(t, New(2026, January, 10), New(2026, January, 20)), i)
+
+	_, ok = RangeFrom(New(2026, January, 21)).Intersect(RangeTo(New(2026, January, 20)))
+	assert.False(t, ok)
+}
+
+func Test_Range_Union(t *testing.T) {
+	jan := mustRange(t, New(2026, January, 1), New(2026, January, 31))
+	feb := mustRange(t, New(2026, February, 1), New(2026, February, 28))
+	mar := mustRange(t, New(2026, March, 1), New(2026, March, 31))
+
+	u, ok := jan.Union(feb)
+	assert.True(t, ok)
+	assert.Equal(t, mustRange(t, New(2026, January, 1), New(2026, February, 28)), u)
+
+	u, ok = feb.Union(jan)
+	assert.True(t, ok)
+	assert.Equal(t, mustRange(t, New(2026, January, 1), New(2026, February, 28)), u)
+
+	_, ok = jan.Union(mar)
+	assert.False(t, ok)
+
+	u, ok = jan.Union(RangeFrom(New(2026, January, 15)))
+	assert.True(t, ok)
+	assert.Equal(t, RangeFrom(New(2026, January, 1)), u)
+
+	u, ok = RangeTo(New(2026, January, 15)).Union(jan)
+	assert.True(t, ok)
+	assert.Equal(t, RangeTo(New(2026, January, 31)), u)
+
+	u, ok = jan.Union(Range{})
+	assert.True(t, ok)
+	assert.Equal(t, Range{}, u)
+}
+
+func Test_Range_Split(t *testing.T) {
+	r := mustRange(t, New(2026, January, 30), New(2026, March, 2))
+	assert.Equal(t, []Range{
+		mustRange(t, New(2026, January, 30), New(2026, January, 31)),
+		mustRange(t, New(2026, February, 1), New(2026, February, 28)),
+		mustRange(t, New(2026, March, 1), New(2026, March, 2)),
+	}, r.Split(ByMonth))
+
+	// 2026-01-30 is Friday
+	r = mustRange(t, New(2026, January, 30), New(2026, February, 10))
+	assert.Equal(t, []Range{
+		mustRange(t, New(2026, January, 30), New(2026, February, 1)),
+		mustRange(t, New(2026, February, 2), New(2026, February, 8)),
+		mustRange(t, New(2026, February, 9), New(2026, February, 10)),
+	}, r.Split(ByWeek))
+
+	r = mustRange(t, New(2025, December, 31), New(2026, January, 1))
+	assert.Equal(t, []Range{
+		mustRange(t, New(2025, December, 31), New(2025, December, 31)),
+		mustRange(t, New(2026, January, 1), New(2026, January, 1)),
+	}, r.Split(ByYear))
+
+	assert.Nil(t, r.Split(SplitBy(0)))
+	assert.Nil(t, RangeFrom(Date{}).Split(ByMonth))
+}
+
+func Test_Range_Filter(t *testing.T) {
+	from, to := New(2026, January, 1), New(2026, January, 31)
+	assert.Equal(t, filterNo{}, Range{}.Filter())
+	assert.Equal(t, &filterFrom{from: from}, RangeFrom(from).Filter())
+	assert.Equal(t, &filterTo{to: to}, RangeTo(to).Filter())
+	assert.Equal(t, &filterDate{date: from}, mustRange(t, from, from).Filter())
+	assert.Equal(t, &filterFromTo{from: from, to: to}, mustRange(t, from, to).Filter())
+}
+
+func Test_ParseRange(t *testing.T) {
+	r, err := ParseRange("2026-01-01..2026-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, mustRange(t, New(2026, January, 1), New(2026, January, 31)), r)
+	r, err = ParseRange([]byte("2026-01-01"))
+	require.NoError(t, err)
+	assert.Equal(t, mustRange(t, New(2026, January, 1), New(2026, January, 1)), r)
+	_, err = ParseRange("2026-02-01..2026-01-01")
+	assert.True(t, errors.Is(err, ErrInvalidFromOrTo))
+	assert.EqualError(t, err, `date.ParseRange: "2026-02-01..2026-01-01": invalid from or to: 2026-02-01 > 2026-01-01`)
+
+	for _, input := range []string{"2026-02-01xx", "2026-02-01xx..", "..2026-02-01xx"} {
+		_, err = ParseRange(input)
+		assert.True(t, errors.Is(err, ErrInvalidFromOrTo), input)
+		var parseErr *ParseError[[]byte]
+		require.True(t, errors.As(err, &parseErr), input)
+		assert.Equal(t, "DefaultParser", parseErr.Func, input)
+		assert.True(t, errors.Is(err, ErrInputTooLong), input)
+	}
+	_, err = ParseRange("2026-13-01..")
+	assert.EqualError(t, err, `date.ParseRange: "2026-13-01..": invalid from or to: date.DefaultParser: "2026-13-01": invalid date`)
+}
+
+func Test_ParseRange_relative(t *testing.T) {
+	defer func() {
+		Now = time.Now
+		DefaultRule = 0
+	}()
+	Now = NewFakeNow(time.Date(2026, October, 14, 12, 0, 0, 0, time.Local)).Now
+	_, err := ParseRange("yesterday..+3d")
+	assert.True(t, errors.Is(err, ErrInvalidFromOrTo))
+
+	DefaultRule = RuleEnableRelative
+	r, err := ParseRange("yesterday..+3d")
+	require.NoError(t, err)
+	assert.Equal(t, mustRange(t, New(2026, October, 13), New(2026, October, 17)), r)
+	r, err = ParseRange("start of month..")
+	require.NoError(t, err)
+	assert.Equal(t, RangeFrom(New(2026, October, 1)), r)
+	f, err := ParseFilter("..today")
+	require.NoError(t, err)
+	assert.Equal(t, &filterTo{to: New(2026, October, 14)}, f)
+	r = Range{}
+	require.NoError(t, r.UnmarshalText([]byte("today")))
+	assert.Equal(t, mustRange(t, New(2026, October, 14), New(2026, October, 14)), r)
+}
+
+var rangeTextCases = []test.CaseText[Range]{
+	{ // 0
+		Data:  `..`,
+		Value: Range{},
+	},
+	{ // 1
+		Data:  `2026-01-01..`,
+		Value: RangeFrom(New(2026, January, 1)),
+	},
+	{ // 2
+		Data:  `..2026-02-01`,
+		Value: RangeTo(New(2026, February, 1)),
+	},
+	{ // 3
+		Data:  `2026-01-01..2026-02-01`,
+		Value: Range{from: New(2026, January, 1), to: New(2026, February, 1), hasFrom: true, hasTo: true},
+	},
+	{ // 4
+		Data:  `2026-01-01`,
+		Value: Range{from: New(2026, January, 1), to: New(2026, January, 1), hasFrom: true, hasTo: true},
+	},
+	{ // 5
+		Constraint: test.OnlyUnmarshal,
+		Data:       `20260101..20260201`,
+		Value:      Range{from: New(2026, January, 1), to: New(2026, February, 1), hasFrom: true, hasTo: true},
+	},
+	{ // 6
+		Constraint: test.OnlyUnmarshal,
+		Error:      test.Error(`date.Range.UnmarshalText: date.ParseRange: invalid from or to: date.DefaultParser: invalid date`),
+		Data:       ``,
+	},
+	{ // 7
+		Constraint: test.OnlyUnmarshal,
+		Error:      test.Error(`date.Range.UnmarshalText: date.ParseRange: "x..": invalid from or to: date.DefaultParser: "x": invalid date`),
+		Data:       `x..`,
+	},
+	{ // 8
+		Constraint: test.OnlyUnmarshal,
+		Error:      test.Error(`date.Range.UnmarshalText: date.ParseRange: "..x": invalid from or to: date.DefaultParser: "x": invalid date`),
+		Data:       `..x`,
+	},
+	{ // 9
+		Constraint: test.OnlyUnmarshal,
+		Error:      test.Error(`date.Range.UnmarshalText: date.ParseRange: "2026-01-02..2026-01-01": invalid from or to: 2026-01-02 > 2026-01-01`),
+		Data:       `2026-01-02..2026-01-01`,
+	},
+}
+
+func Test_Range_MarshalText(t *testing.T) {
+	Formatter = DefaultFormatter
+	test.MarshalText(t, rangeTextCases)
+}
+
+func Test_Range_UnmarshalText(t *testing.T) {
+	test.UnmarshalText(t, rangeTextCases, nil)
+}
+
+var rangeJSONCases = []test.CaseJSON[Range]{
+	{ // 0
+		Data:  `".."`,
+		Value: Range{},
+	},
+	{ // 1
+		Data:  `"2026-01-01..2026-02-01"`,
+		Value: Range{from: New(2026, January, 1), to: New(2026, February, 1), hasFrom: true, hasTo: true},
+	},
+	{ // 2
+		Constraint: test.OnlyUnmarshal,
+		Data:       `null`,
+		Value:      Range{},
+	},
+	{ // 3
+		Constraint: test.OnlyUnmarshal,
+		Error:      test.Error(`date.Range.UnmarshalJSON: json: cannot unmarshal number into Go value of type string`),
+		Data:       `1`,
+	},
+	{ // 4
+		Constraint: test.OnlyUnmarshal,
+		Error:      test.Error(`date.Range.UnmarshalJSON: date.ParseRange: "x": invalid from or to: date.DefaultParser: "x": invalid date`),
+		Data:       `"x"`,
+	},
+}
+
+func Test_Range_MarshalJSON(t *testing.T) {
+	Formatter = DefaultFormatter
+	test.MarshalJSON(t, rangeJSONCases)
+}
+
+func Test_Range_UnmarshalJSON(t *testing.T) {
+	test.UnmarshalJSON(t, rangeJSONCases, nil)
+}
+
+func Test_Range_marshal_Formatter_error(t *testing.T) {
+	defer func() {
+		Formatter = DefaultFormatter
+	}()
+	Formatter = func(buf []byte, d Date, f Format) ([]byte, error) {
+		return nil, errors.New("error")
+	}
+	for _, r := range []Range{RangeFrom(Date{}), RangeTo(Date{}), mustRange(t, Date{}, Date{})} {
+		_, err := r.MarshalText()
+		assert.EqualError(t, err, "date.Range.MarshalText: error")
+		_, err = r.MarshalJSON()
+		assert.EqualError(t, err, "date.Range.MarshalJSON: error")
+	}
+}
+
+func Test_Range_String(t *testing.T) {
+	Formatter = DefaultFormatter
+	assert.Equal(t, `..`, Range{}.String())
+	assert.Equal(t, `2026-01-01..`, RangeFrom(New(2026, January, 1)).String())
+	assert.Equal(t, `..2026-01-01`, RangeTo(New(2026, January, 1)).String())
+	assert.Equal(t, `2026-01-01`, mustRange(t, New(2026, January, 1), New(2026, January, 1)).String())
+	assert.Equal(t, `2026-01-01..2026-01-02`, mustRange(t, New(2026, January, 1), New(2026, January, 2)).String())
+}
+
+func Test_Range_json_struct(t *testing.T) {
+	Formatter = DefaultFormatter
+	v := struct {
+		Period Range `json:"period"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(`{"period":"2026-01-01.."}`), &v))
+	assert.Equal(t, RangeFrom(New(2026, January, 1)), v.Period)
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.Equal(t, `{"period":"2026-01-01.."}`, string(b))
+}
+
+func ExampleRange_Split() {
+	r, _ := NewRangeHalfOpen(New(2026, January, 30), New(2026, March, 3))
+	for _, month := range r.Split(ByMonth) {
+		from, _ := month.From()
+		to, _ := month.To()
+		fmt.Println(from, to, month.Len())
+	}
+	// Output:
+	// 2026-01-30 2026-01-31 2
+	// 2026-02-01 2026-02-28 28
+	// 2026-03-01 2026-03-02 2
+}
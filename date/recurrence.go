@@ -0,0 +1,612 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.lstv.dev/util/constraint"
+)
+
+// Frequency represents FREQ part of recurrence rule.
+// Available values are:
+//   Daily
+//   Weekly
+//   Monthly
+//   Yearly
+type Frequency int
+
+const (
+	// Daily repeats recurrence every day (FREQ=DAILY).
+	Daily = Frequency(iota + 1)
+
+	// Weekly repeats recurrence every week (FREQ=WEEKLY).
+	Weekly
+
+	// Monthly repeats recurrence every month (FREQ=MONTHLY).
+	Monthly
+
+	// Yearly repeats recurrence every year (FREQ=YEARLY).
+	Yearly
+)
+
+// recurrenceMaxGap is the longest period without any occurrence after which iteration stops.
+// Gregorian calendar repeats every 400 years, so no occurrence will be found later.
+const recurrenceMaxGap = 400
+
+var (
+	frequencyNames = [...]string{"", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+	weekdayCodes   = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+)
+
+// String returns RFC 5545 frequency name, e.g. "WEEKLY".
+func (f Frequency) String() string {
+	if f >= Daily && f <= Yearly {
+		return frequencyNames[f]
+	}
+	return "Frequency(" + strconv.Itoa(int(f)) + ")"
+}
+
+// WeekdayNum represents one item of BYDAY part of recurrence rule, e.g. 2SU or -1FR.
+// N is ordinal of weekday within month or year (negative counts from the end),
+// zero N means every such weekday.
+type WeekdayNum struct {
+	N       int
+	Weekday Weekday
+}
+
+// String returns RFC 5545 form of weekday, e.g. "-1FR".
+func (w WeekdayNum) String() string {
+	code := "Weekday(" + strconv.Itoa(int(w.Weekday)) + ")"
+	if w.Weekday >= Sunday && w.Weekday <= Saturday {
+		code = weekdayCodes[w.Weekday]
+	}
+	if w.N == 0 {
+		return code
+	}
+	return strconv.Itoa(w.N) + code
+}
+
+// Recurrence represents RFC 5545 recurrence rule (RRULE) limited to date granularity.
+// Supported rule parts are FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYMONTH, BYSETPOS, COUNT and UNTIL,
+// week always starts on Monday (WKST=MO).
+//
+// Start is the first date of recurrence (DTSTART) and also provides defaults
+// for missing BYDAY, BYMONTHDAY and BYMONTH parts.
+//
+// Recurrence generates dates lazily and implements Filter.
+type Recurrence struct {
+	Start      Date
+	Freq       Frequency
+	Interval   int // zero is the same as 1
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []Month
+	BySetPos   []int
+	Count      int   // zero means no limit
+	Until      *Date // nil means no limit
+}
+
+// ParseRecurrence parses RFC 5545 recurrence rule, e.g. "FREQ=MONTHLY;BYDAY=2SU".
+// Optional "RRULE:" prefix is allowed, rule parts are case-insensitive.
+// Passed start date is used as Recurrence.Start.
+// UNTIL can be date (e.g. 20261018) or date-time (e.g. 20261018T235959Z), time is ignored.
+// It can return *ParseError wrapping ErrInvalidRecurrence.
+func ParseRecurrence[T constraint.ParserInput](start Date, input T) (Recurrence, error) {
+	r, err := parseRecurrence(string(input))
+	if err != nil {
+		return Recurrence{}, newParseError("ParseRecurrence", input, err)
+	}
+	r.Start = start
+	return r, nil
+}
+
+// Dates calls yield for each date of recurrence in ascending order.
+// If yield returns false, iteration stops.
+// If recurrence has neither Count nor Until, iteration continues until yield returns false
+// or until no other date can be generated.
+func (r Recurrence) Dates(yield func(date Date) bool) {
+	if r.Freq < Daily || r.Freq > Yearly {
+		return
+	}
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	count := 0
+	last := r.Start
+	for period := r.periodStart(r.Start); ; period = r.nextPeriod(period, interval) {
+		if r.Until != nil && period.After(*r.Until) {
+			return
+		}
+		if period.Year()-last.Year() > recurrenceMaxGap {
+			return
+		}
+		for _, d := range r.setPos(r.expand(period)) {
+			if d.Before(r.Start) {
+				continue
+			}
+			if r.Until != nil && d.After(*r.Until) {
+				return
+			}
+			if !yield(d) {
+				return
+			}
+			last = d
+			count++
+			if r.Count > 0 && count >= r.Count {
+				return
+			}
+		}
+	}
+}
+
+// Next returns the first date of recurrence after passed date.
+// Returned ok is false if there is no such date.
+func (r Recurrence) Next(after Date) (next Date, ok bool) {
+	r.Dates(func(d Date) bool {
+		if d.After(after) {
+			next, ok = d, true
+			return false
+		}
+		return true
+	})
+	return next, ok
+}
+
+// Contains returns true if passed date is one of recurrence dates.
+// It allows using Recurrence as Filter.
+func (r Recurrence) Contains(date Date) bool {
+	found := false
+	r.Dates(func(d Date) bool {
+		found = d.Equal(date)
+		return d.Before(date)
+	})
+	return found
+}
+
+// Describe returns description of recurrence, e.g. "FREQ=WEEKLY;BYDAY=SU from 2026-01-04".
+func (r Recurrence) Describe() string {
+	return r.String() + " from " + r.Start.String()
+}
+
+// MarshalText converts recurrence rule to RFC 5545 form without "RRULE:" prefix.
+// Start is not part of text form.
+// It can return wrapped ErrInvalidRecurrence.
+func (r Recurrence) MarshalText() ([]byte, error) {
+	if err := r.validate(); err != nil {
+		return nil, fmt.Errorf("date.Recurrence.MarshalText: %w", err)
+	}
+	return r.appendText(nil), nil
+}
+
+// UnmarshalText parses recurrence rule, see ParseRecurrence.
+// Start is kept unchanged.
+func (r *Recurrence) UnmarshalText(data []byte) error {
+	v, err := ParseRecurrence(r.Start, data)
+	if err != nil {
+		return fmt.Errorf("date.Recurrence.UnmarshalText: %w", err)
+	}
+	*r = v
+	return nil
+}
+
+// String returns RFC 5545 form of recurrence rule without "RRULE:" prefix.
+func (r Recurrence) String() string {
+	return string(r.appendText(nil))
+}
+
+func (r Recurrence) appendText(buf []byte) []byte {
+	buf = append(buf, "FREQ="...)
+	buf = append(buf, r.Freq.String()...)
+	if r.Interval > 1 {
+		buf = append(buf, ";INTERVAL="...)
+		buf = strconv.AppendInt(buf, int64(r.Interval), 10)
+	}
+	if r.Count > 0 {
+		buf = append(buf, ";COUNT="...)
+		buf = strconv.AppendInt(buf, int64(r.Count), 10)
+	}
+	if r.Until != nil {
+		buf = append(buf, ";UNTIL="...)
+		buf, _ = DefaultFormatter(buf, *r.Until, FormatBasic)
+	}
+	if len(r.ByMonth) != 0 {
+		buf = append(buf, ";BYMONTH="...)
+		for i, m := range r.ByMonth {
+			if i != 0 {
+				buf = append(buf, ',')
+			}
+			buf = strconv.AppendInt(buf, int64(m), 10)
+		}
+	}
+	buf = appendInts(buf, ";BYMONTHDAY=", r.ByMonthDay)
+	if len(r.ByDay) != 0 {
+		buf = append(buf, ";BYDAY="...)
+		for i, w := range r.ByDay {
+			if i != 0 {
+				buf = append(buf, ',')
+			}
+			buf = append(buf, w.String()...)
+		}
+	}
+	return appendInts(buf, ";BYSETPOS=", r.BySetPos)
+}
+
+// validate checks rule parts combinations as RFC 5545 requires.
+func (r Recurrence) validate() error {
+	if r.Freq < Daily || r.Freq > Yearly {
+		return fmt.Errorf("%w: unsupported frequency %s", ErrInvalidRecurrence, r.Freq)
+	}
+	if r.Count != 0 && r.Until != nil {
+		return fmt.Errorf("%w: COUNT and UNTIL must not occur together", ErrInvalidRecurrence)
+	}
+	if r.Interval < 0 || r.Count < 0 {
+		return fmt.Errorf("%w: INTERVAL and COUNT must be positive", ErrInvalidRecurrence)
+	}
+	if r.Freq == Weekly && len(r.ByMonthDay) != 0 {
+		return fmt.Errorf("%w: BYMONTHDAY must not be used with FREQ=WEEKLY", ErrInvalidRecurrence)
+	}
+	for _, w := range r.ByDay {
+		if w.Weekday < Sunday || w.Weekday > Saturday || w.N < -53 || w.N > 53 {
+			return fmt.Errorf("%w: invalid BYDAY value %s", ErrInvalidRecurrence, w)
+		}
+		if w.N != 0 && (r.Freq == Daily || r.Freq == Weekly) {
+			return fmt.Errorf("%w: BYDAY with ordinal must be used only with FREQ=MONTHLY or FREQ=YEARLY", ErrInvalidRecurrence)
+		}
+	}
+	for _, d := range r.ByMonthDay {
+		if d == 0 || d < -31 || d > 31 {
+			return fmt.Errorf("%w: invalid BYMONTHDAY value %d", ErrInvalidRecurrence, d)
+		}
+	}
+	for _, m := range r.ByMonth {
+		if m < January || m > December {
+			return fmt.Errorf("%w: invalid BYMONTH value %d", ErrInvalidRecurrence, m)
+		}
+	}
+	for _, p := range r.BySetPos {
+		if p == 0 || p < -366 || p > 366 {
+			return fmt.Errorf("%w: invalid BYSETPOS value %d", ErrInvalidRecurrence, p)
+		}
+	}
+	return nil
+}
+
+// periodStart returns the first date of period (day, week, month or year) containing passed date.
+func (r Recurrence) periodStart(d Date) Date {
+	switch r.Freq {
+	case Weekly:
+		return d.Add(0, 0, 1-isoWeekday(d.Weekday()))
+	case Monthly:
+		return New(d.Year(), d.Month(), 1)
+	case Yearly:
+		return New(d.Year(), January, 1)
+	default: // Daily
+		return d
+	}
+}
+
+// nextPeriod returns the first date of period which is interval periods after passed one.
+func (r Recurrence) nextPeriod(period Date, interval int) Date {
+	switch r.Freq {
+	case Weekly:
+		return period.Add(0, 0, 7*interval)
+	case Monthly:
+		return period.Add(0, interval, 0)
+	case Yearly:
+		return period.Add(interval, 0, 0)
+	default: // Daily
+		return period.Add(0, 0, interval)
+	}
+}
+
+// expand returns sorted candidate dates of period starting at passed date.
+func (r Recurrence) expand(period Date) []Date {
+	dates := []Date(nil)
+	switch r.Freq {
+	case Daily:
+		if r.limitMonth(period) && r.limitMonthDay(period) && r.limitWeekday(period) {
+			dates = append(dates, period)
+		}
+	case Weekly:
+		for i := 0; i < 7; i++ {
+			d := period.Add(0, 0, i)
+			if r.limitMonth(d) && r.limitWeekdayOrStart(d) {
+				dates = append(dates, d)
+			}
+		}
+	case Monthly:
+		if r.limitMonth(period) {
+			dates = r.expandMonth(period)
+		}
+	case Yearly:
+		switch {
+		case len(r.ByMonth) != 0:
+			for _, m := range r.ByMonth {
+				dates = append(dates, r.expandMonth(New(period.Year(), m, 1))...)
+			}
+		case len(r.ByMonthDay) != 0:
+			for m := January; m <= December; m++ {
+				dates = append(dates, r.expandMonth(New(period.Year(), m, 1))...)
+			}
+		case len(r.ByDay) != 0:
+			dates = r.expandByDay(period, New(period.Year(), December, 31))
+		default:
+			if d := New(period.Year(), r.Start.Month(), r.Start.Day()); d.Day() == r.Start.Day() {
+				dates = append(dates, d)
+			}
+		}
+	}
+	Sort(dates)
+	return Dedupe(dates)
+}
+
+// expandMonth returns candidate dates of month starting at passed date.
+func (r Recurrence) expandMonth(first Date) []Date {
+	last := first.EndOfMonth()
+	switch {
+	case len(r.ByMonthDay) != 0:
+		dates := []Date(nil)
+		byDay := r.expandByDay(first, last)
+		Sort(byDay)
+		for _, day := range r.ByMonthDay {
+			if day < 0 {
+				day = last.Day() + day + 1
+			}
+			if day < 1 || day > last.Day() {
+				continue
+			}
+			d := New(first.Year(), first.Month(), day)
+			if len(r.ByDay) != 0 {
+				if _, ok := BinarySearch(byDay, d); !ok {
+					continue
+				}
+			}
+			dates = append(dates, d)
+		}
+		return dates
+	case len(r.ByDay) != 0:
+		return r.expandByDay(first, last)
+	default:
+		if day := r.Start.Day(); day <= last.Day() {
+			return []Date{New(first.Year(), first.Month(), day)}
+		}
+		return nil
+	}
+}
+
+// expandByDay returns dates from passed range matching BYDAY part.
+func (r Recurrence) expandByDay(first, last Date) []Date {
+	dates := []Date(nil)
+	for _, w := range r.ByDay {
+		firstOf := first.Add(0, 0, (int(w.Weekday)-int(first.Weekday())+7)%7)
+		lastOf := last.Add(0, 0, -((int(last.Weekday()) - int(w.Weekday) + 7) % 7))
+		switch {
+		case w.N > 0:
+			if d := firstOf.Add(0, 0, 7*(w.N-1)); !d.After(last) {
+				dates = append(dates, d)
+			}
+		case w.N < 0:
+			if d := lastOf.Add(0, 0, 7*(w.N+1)); !d.Before(first) {
+				dates = append(dates, d)
+			}
+		default:
+			for d := firstOf; !d.After(last); d = d.Add(0, 0, 7) {
+				dates = append(dates, d)
+			}
+		}
+	}
+	return dates
+}
+
+// setPos applies BYSETPOS part to sorted candidate dates.
+func (r Recurrence) setPos(dates []Date) []Date {
+	if len(r.BySetPos) == 0 {
+		return dates
+	}
+	selected := []Date(nil)
+	for _, p := range r.BySetPos {
+		if p < 0 {
+			p = len(dates) + p + 1
+		}
+		if p >= 1 && p <= len(dates) {
+			selected = append(selected, dates[p-1])
+		}
+	}
+	Sort(selected)
+	return Dedupe(selected)
+}
+
+func (r Recurrence) limitMonth(d Date) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.ByMonth {
+		if d.Month() == m {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Recurrence) limitMonthDay(d Date) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	last := monthDays(d.Year(), d.Month())
+	for _, day := range r.ByMonthDay {
+		if d.Day() == day || last+day+1 == d.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Recurrence) limitWeekday(d Date) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, w := range r.ByDay {
+		if d.Weekday() == w.Weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Recurrence) limitWeekdayOrStart(d Date) bool {
+	if len(r.ByDay) == 0 {
+		return d.Weekday() == r.Start.Weekday()
+	}
+	return r.limitWeekday(d)
+}
+
+func parseRecurrence(input string) (Recurrence, error) {
+	const prefix = "RRULE:"
+	r := Recurrence{}
+	if len(input) >= len(prefix) && strings.EqualFold(input[:len(prefix)], prefix) {
+		input = input[len(prefix):]
+	}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(input, ";") {
+		i := strings.IndexByte(part, '=')
+		if i < 0 {
+			return Recurrence{}, fmt.Errorf("%w: invalid part %q", ErrInvalidRecurrence, part)
+		}
+		key, value := strings.ToUpper(part[:i]), part[i+1:]
+		if seen[key] {
+			return Recurrence{}, fmt.Errorf("%w: duplicated part %s", ErrInvalidRecurrence, key)
+		}
+		seen[key] = true
+		if err := r.parsePart(key, value); err != nil {
+			return Recurrence{}, err
+		}
+	}
+	if r.Freq == 0 {
+		return Recurrence{}, fmt.Errorf("%w: missing FREQ part", ErrInvalidRecurrence)
+	}
+	if err := r.validate(); err != nil {
+		return Recurrence{}, err
+	}
+	return r, nil
+}
+
+func (r *Recurrence) parsePart(key, value string) (err error) {
+	switch key {
+	case "FREQ":
+		for f := Daily; f <= Yearly; f++ {
+			if strings.EqualFold(value, frequencyNames[f]) {
+				r.Freq = f
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: unsupported frequency %q", ErrInvalidRecurrence, value)
+	case "INTERVAL":
+		r.Interval, err = parsePositive(key, value)
+		return err
+	case "COUNT":
+		r.Count, err = parsePositive(key, value)
+		return err
+	case "UNTIL":
+		if i := strings.IndexAny(value, "Tt"); i >= 0 {
+			value = value[:i]
+		}
+		d, err := DefaultParser(value, RuleDisableWeek|RuleDisableOrdinal)
+		if err != nil || len(value) != 8 {
+			return fmt.Errorf("%w: invalid UNTIL value %q", ErrInvalidRecurrence, value)
+		}
+		r.Until = &d
+		return nil
+	case "BYDAY":
+		for _, v := range strings.Split(value, ",") {
+			w, err := parseWeekdayNum(v)
+			if err != nil {
+				return err
+			}
+			r.ByDay = append(r.ByDay, w)
+		}
+		return nil
+	case "BYMONTHDAY":
+		r.ByMonthDay, err = parseInts(key, value)
+		return err
+	case "BYMONTH":
+		months, err := parseInts(key, value)
+		for _, m := range months {
+			r.ByMonth = append(r.ByMonth, Month(m))
+		}
+		return err
+	case "BYSETPOS":
+		r.BySetPos, err = parseInts(key, value)
+		return err
+	case "WKST":
+		if !strings.EqualFold(value, weekdayCodes[Monday]) {
+			return fmt.Errorf("%w: unsupported WKST value %q", ErrInvalidRecurrence, value)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported part %s", ErrInvalidRecurrence, key)
+	}
+}
+
+func parseWeekdayNum(value string) (WeekdayNum, error) {
+	l := len(value)
+	if l < 2 {
+		return WeekdayNum{}, fmt.Errorf("%w: invalid BYDAY value %q", ErrInvalidRecurrence, value)
+	}
+	w := WeekdayNum{Weekday: -1}
+	for i, code := range weekdayCodes {
+		if strings.EqualFold(value[l-2:], code) {
+			w.Weekday = Weekday(i)
+		}
+	}
+	if w.Weekday < 0 {
+		return WeekdayNum{}, fmt.Errorf("%w: invalid BYDAY value %q", ErrInvalidRecurrence, value)
+	}
+	if l > 2 {
+		n, err := strconv.Atoi(value[:l-2])
+		if err != nil || n == 0 {
+			return WeekdayNum{}, fmt.Errorf("%w: invalid BYDAY value %q", ErrInvalidRecurrence, value)
+		}
+		w.N = n
+	}
+	return w, nil
+}
+
+func parsePositive(key, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%w: invalid %s value %q", ErrInvalidRecurrence, key, value)
+	}
+	return n, nil
+}
+
+func parseInts(key, value string) ([]int, error) {
+	ints := []int(nil)
+	for _, v := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid %s value %q", ErrInvalidRecurrence, key, v)
+		}
+		ints = append(ints, n)
+	}
+	return ints, nil
+}
+
+func appendInts(buf []byte, prefix string, ints []int) []byte {
+	if len(ints) == 0 {
+		return buf
+	}
+	buf = append(buf, prefix...)
+	for i, n := range ints {
+		if i != 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendInt(buf, int64(n), 10)
+	}
+	return buf
+}
@@ -0,0 +1,279 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.lstv.dev/util/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recurrenceDates(t *testing.T, start Date, rule string, limit int) []Date {
+	t.Helper()
+	r, err := ParseRecurrence(start, rule)
+	require.NoError(t, err)
+	dates := []Date(nil)
+	r.Dates(func(d Date) bool {
+		dates = append(dates, d)
+		return len(dates) < limit
+	})
+	return dates
+}
+
+func Test_Recurrence_Dates(t *testing.T) {
+	assert.Equal(t, []Date{
+		New(2026, January, 11),
+		New(2026, February, 8),
+		New(2026, March, 8),
+	}, recurrenceDates(t, New(2026, January, 1), "FREQ=MONTHLY;BYDAY=2SU;COUNT=3", 10))
+
+	assert.Equal(t, []Date{
+		New(2026, January, 30),
+		New(2026, February, 27),
+		New(2026, March, 31),
+	}, recurrenceDates(t, New(2026, January, 1), "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", 3))
+
+	assert.Equal(t, []Date{
+		New(2026, October, 13),
+		New(2026, October, 15),
+		New(2026, October, 27),
+		New(2026, October, 29),
+	}, recurrenceDates(t, New(2026, October, 13), "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;UNTIL=20261030", 10))
+
+	assert.Equal(t, []Date{
+		New(2026, October, 18),
+		New(2026, October, 25),
+	}, recurrenceDates(t, New(2026, October, 18), "FREQ=WEEKLY", 2))
+
+	assert.Equal(t, []Date{
+		New(2026, January, 1),
+		New(2026, January, 2),
+	}, recurrenceDates(t, New(2025, December, 30), "FREQ=DAILY;BYMONTH=1;COUNT=2", 10))
+
+	assert.Equal(t, []Date{
+		New(2026, January, 3),
+		New(2026, January, 10),
+	}, recurrenceDates(t, New(2026, January, 1), "FREQ=DAILY;BYDAY=SA;BYMONTHDAY=3,10,11", 2))
+
+	assert.Equal(t, []Date{
+		New(2024, February, 29),
+		New(2028, February, 29),
+	}, recurrenceDates(t, New(2024, February, 29), "FREQ=YEARLY;COUNT=2", 10))
+
+	assert.Equal(t, []Date{
+		New(2026, January, 31),
+		New(2026, March, 31),
+		New(2026, May, 31),
+	}, recurrenceDates(t, New(2026, January, 31), "FREQ=MONTHLY", 3))
+
+	assert.Equal(t, []Date{
+		New(2026, January, 31),
+		New(2026, February, 28),
+		New(2026, March, 31),
+	}, recurrenceDates(t, New(2026, January, 1), "FREQ=MONTHLY;BYMONTHDAY=-1", 3))
+
+	assert.Equal(t, []Date{
+		New(2026, February, 13),
+		New(2026, March, 13),
+		New(2026, November, 13),
+	}, recurrenceDates(t, New(2026, January, 1), "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", 3))
+
+	assert.Equal(t, []Date{
+		New(2026, May, 18),
+	}, recurrenceDates(t, New(2026, January, 1), "FREQ=YEARLY;BYDAY=20MO;COUNT=1", 10))
+
+	assert.Equal(t, []Date{
+		New(2026, December, 28),
+		New(2027, December, 27),
+	}, recurrenceDates(t, New(2026, January, 1), "FREQ=YEARLY;BYDAY=-1MO", 2))
+
+	assert.Equal(t, []Date{
+		New(2026, March, 29),
+		New(2026, October, 25),
+		New(2027, March, 28),
+	}, recurrenceDates(t, New(2026, January, 1), "FREQ=YEARLY;BYMONTH=3,10;BYDAY=-1SU", 3))
+
+	assert.Equal(t, []Date{
+		New(2026, January, 15),
+		New(2026, February, 15),
+	}, recurrenceDates(t, New(2026, January, 1), "FREQ=YEARLY;BYMONTHDAY=15", 2))
+
+	assert.Equal(t, []Date{
+		New(2026, October, 18),
+		New(2028, October, 18),
+	}, recurrenceDates(t, New(2026, October, 18), "FREQ=YEARLY;INTERVAL=2", 2))
+
+	assert.Nil(t, recurrenceDates(t, New(2026, January, 1), "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", 10))
+	assert.Nil(t, recurrenceDates(t, New(2026, January, 1), "FREQ=DAILY;UNTIL=20251231", 10))
+
+	dates := []Date(nil)
+	Recurrence{}.Dates(func(d Date) bool {
+		dates = append(dates, d)
+		return true
+	})
+	assert.Nil(t, dates)
+}
+
+func Test_Recurrence_Next(t *testing.T) {
+	r, err := ParseRecurrence(New(2026, January, 1), "FREQ=MONTHLY;BYDAY=2SU;COUNT=3")
+	require.NoError(t, err)
+	next, ok := r.Next(New(2026, January, 11))
+	assert.True(t, ok)
+	assert.Equal(t, New(2026, February, 8), next)
+	_, ok = r.Next(New(2026, March, 8))
+	assert.False(t, ok)
+}
+
+func Test_Recurrence_Contains(t *testing.T) {
+	r, err := ParseRecurrence(New(2026, January, 1), "FREQ=MONTHLY;BYDAY=2SU")
+	require.NoError(t, err)
+	assert.False(t, r.Contains(New(2025, December, 14)))
+	assert.True(t, r.Contains(New(2026, January, 11)))
+	assert.False(t, r.Contains(New(2026, January, 12)))
+	assert.True(t, r.Contains(New(2026, October, 11)))
+	assert.False(t, r.Contains(New(2026, October, 18)))
+}
+
+func Test_Recurrence_Describe(t *testing.T) {
+	Formatter = DefaultFormatter
+	r, err := ParseRecurrence(New(2026, January, 1), "FREQ=MONTHLY;BYDAY=2SU")
+	require.NoError(t, err)
+	assert.Equal(t, "FREQ=MONTHLY;BYDAY=2SU from 2026-01-01", Describe(r))
+}
+
+func Test_ParseRecurrence(t *testing.T) {
+	until := New(2026, December, 31)
+	r, err := ParseRecurrence(New(2026, January, 1), "rrule:freq=weekly;interval=2;byday=mo,fr;until=20261231T235959Z;wkst=mo")
+	require.NoError(t, err)
+	assert.Equal(t, Recurrence{
+		Start:    New(2026, January, 1),
+		Freq:     Weekly,
+		Interval: 2,
+		ByDay:    []WeekdayNum{{Weekday: Monday}, {Weekday: Friday}},
+		Until:    &until,
+	}, r)
+
+	r, err = ParseRecurrence(Date{}, []byte("FREQ=YEARLY;BYMONTH=1,7;BYMONTHDAY=1,-1;BYDAY=+1MO,-2SU;BYSETPOS=1,-1;COUNT=5"))
+	require.NoError(t, err)
+	assert.Equal(t, Recurrence{
+		Freq:       Yearly,
+		ByDay:      []WeekdayNum{{N: 1, Weekday: Monday}, {N: -2, Weekday: Sunday}},
+		ByMonthDay: []int{1, -1},
+		ByMonth:    []Month{January, July},
+		BySetPos:   []int{1, -1},
+		Count:      5,
+	}, r)
+
+	failures := map[string]string{
+		"":                                  `date.ParseRecurrence: invalid recurrence rule: invalid part ""`,
+		"FREQ=DAILY;":                       `date.ParseRecurrence: "FREQ=DAILY;": invalid recurrence rule: invalid part ""`,
+		"COUNT=1":                           `date.ParseRecurrence: "COUNT=1": invalid recurrence rule: missing FREQ part`,
+		"FREQ=HOURLY":                       `date.ParseRecurrence: "FREQ=HOURLY": invalid recurrence rule: unsupported frequency "HOURLY"`,
+		"FREQ=DAILY;FREQ=DAILY":             `date.ParseRecurrence: "FREQ=DAILY;FREQ=DAILY": invalid recurrence rule: duplicated part FREQ`,
+		"FREQ=DAILY;BYHOUR=1":               `date.ParseRecurrence: "FREQ=DAILY;BYHOUR=1": invalid recurrence rule: unsupported part BYHOUR`,
+		"FREQ=DAILY;WKST=SU":                `date.ParseRecurrence: "FREQ=DAILY;WKST=SU": invalid recurrence rule: unsupported WKST value "SU"`,
+		"FREQ=DAILY;INTERVAL=0":             `date.ParseRecurrence: "FREQ=DAILY;INTERVAL=0": invalid recurrence rule: invalid INTERVAL value "0"`,
+		"FREQ=DAILY;COUNT=x":                `date.ParseRecurrence: "FREQ=DAILY;COUNT=x": invalid recurrence rule: invalid COUNT value "x"`,
+		"FREQ=DAILY;UNTIL=2026":             `date.ParseRecurrence: "FREQ=DAILY;UNTIL=2026": invalid recurrence rule: invalid UNTIL value "2026"`,
+		"FREQ=DAILY;UNTIL=2026-01-01":       `date.ParseRecurrence: "FREQ=DAILY;UNTIL=2026-01-01": invalid recurrence rule: invalid UNTIL value "2026-01-01"`,
+		"FREQ=DAILY;COUNT=1;UNTIL=20260101": `date.ParseRecurrence: "FREQ=DAILY;COUNT=1;UNTIL=20260101": invalid recurrence rule: COUNT and UNTIL must not occur together`,
+		"FREQ=DAILY;BYDAY=X":                `date.ParseRecurrence: "FREQ=DAILY;BYDAY=X": invalid recurrence rule: invalid BYDAY value "X"`,
+		"FREQ=DAILY;BYDAY=XX":               `date.ParseRecurrence: "FREQ=DAILY;BYDAY=XX": invalid recurrence rule: invalid BYDAY value "XX"`,
+		"FREQ=DAILY;BYDAY=0MO":              `date.ParseRecurrence: "FREQ=DAILY;BYDAY=0MO": invalid recurrence rule: invalid BYDAY value "0MO"`,
+		"FREQ=WEEKLY;BYDAY=1MO":             `date.ParseRecurrence: "FREQ=WEEKLY;BYDAY=1MO": invalid recurrence rule: BYDAY with ordinal must be used only with FREQ=MONTHLY or FREQ=YEARLY`,
+		"FREQ=MONTHLY;BYDAY=54MO":           `date.ParseRecurrence: "FREQ=MONTHLY;BYDAY=54MO": invalid recurrence rule: invalid BYDAY value 54MO`,
+		"FREQ=WEEKLY;BYMONTHDAY=1":          `date.ParseRecurrence: "FREQ=WEEKLY;BYMONTHDAY=1": invalid recurrence rule: BYMONTHDAY must not be used with FREQ=WEEKLY`,
+		"FREQ=MONTHLY;BYMONTHDAY=0":         `date.ParseRecurrence: "FREQ=MONTHLY;BYMONTHDAY=0": invalid recurrence rule: invalid BYMONTHDAY value 0`,
+		"FREQ=MONTHLY;BYMONTHDAY=a":         `date.ParseRecurrence: "FREQ=MONTHLY;BYMONTHDAY=a": invalid recurrence rule: invalid BYMONTHDAY value "a"`,
+		"FREQ=MONTHLY;BYMONTH=13":           `date.ParseRecurrence: "FREQ=MONTHLY;BYMONTH=13": invalid recurrence rule: invalid BYMONTH value 13`,
+		"FREQ=MONTHLY;BYMONTH=x":            `date.ParseRecurrence: "FREQ=MONTHLY;BYMONTH=x": invalid recurrence rule: invalid BYMONTH value "x"`,
+		"FREQ=MONTHLY;BYSETPOS=0":           `date.ParseRecurrence: "FREQ=MONTHLY;BYSETPOS=0": invalid recurrence rule: invalid BYSETPOS value 0`,
+	}
+	for input, expected := range failures {
+		r, err := ParseRecurrence(Date{}, input)
+		assert.Zero(t, r, input)
+		assert.EqualError(t, err, expected, input)
+		assert.True(t, errors.Is(err, ErrInvalidRecurrence), input)
+	}
+}
+
+func Test_Recurrence_MarshalText(t *testing.T) {
+	until := New(2026, December, 31)
+	test.MarshalText(t, []test.CaseText[Recurrence]{
+		{ // 0
+			Data:  `FREQ=DAILY`,
+			Value: Recurrence{Freq: Daily},
+		},
+		{ // 1
+			Data: `FREQ=WEEKLY;INTERVAL=2;UNTIL=20261231;BYDAY=MO,FR`,
+			Value: Recurrence{
+				Freq:     Weekly,
+				Interval: 2,
+				ByDay:    []WeekdayNum{{Weekday: Monday}, {Weekday: Friday}},
+				Until:    &until,
+			},
+		},
+		{ // 2
+			Data: `FREQ=YEARLY;COUNT=5;BYMONTH=1,7;BYMONTHDAY=1,-1;BYDAY=1MO,-2SU;BYSETPOS=1,-1`,
+			Value: Recurrence{
+				Freq:       Yearly,
+				ByDay:      []WeekdayNum{{N: 1, Weekday: Monday}, {N: -2, Weekday: Sunday}},
+				ByMonthDay: []int{1, -1},
+				ByMonth:    []Month{January, July},
+				BySetPos:   []int{1, -1},
+				Count:      5,
+			},
+		},
+		{ // 3
+			Error: test.Error("date.Recurrence.MarshalText: invalid recurrence rule: unsupported frequency Frequency(0)"),
+			Value: Recurrence{},
+		},
+		{ // 4
+			Error: test.Error("date.Recurrence.MarshalText: invalid recurrence rule: INTERVAL and COUNT must be positive"),
+			Value: Recurrence{Freq: Daily, Count: -1},
+		},
+		{ // 5
+			Error: test.Error("date.Recurrence.MarshalText: invalid recurrence rule: invalid BYDAY value Weekday(7)"),
+			Value: Recurrence{Freq: Daily, ByDay: []WeekdayNum{{Weekday: 7}}},
+		},
+	})
+}
+
+func Test_Recurrence_UnmarshalText(t *testing.T) {
+	r := Recurrence{Start: New(2026, January, 1)}
+	require.NoError(t, r.UnmarshalText([]byte("FREQ=MONTHLY;BYDAY=2SU")))
+	assert.Equal(t, Recurrence{Start: New(2026, January, 1), Freq: Monthly, ByDay: []WeekdayNum{{N: 2, Weekday: Sunday}}}, r)
+	assert.EqualError(t, r.UnmarshalText([]byte("FREQ=SECONDLY")), `date.Recurrence.UnmarshalText: date.ParseRecurrence: "FREQ=SECONDLY": invalid recurrence rule: unsupported frequency "SECONDLY"`)
+}
+
+func Test_Frequency_String(t *testing.T) {
+	assert.Equal(t, "DAILY", Daily.String())
+	assert.Equal(t, "YEARLY", Yearly.String())
+	assert.Equal(t, "Frequency(5)", Frequency(5).String())
+}
+
+func Test_WeekdayNum_String(t *testing.T) {
+	assert.Equal(t, "SU", WeekdayNum{Weekday: Sunday}.String())
+	assert.Equal(t, "2SU", WeekdayNum{N: 2, Weekday: Sunday}.String())
+	assert.Equal(t, "-1FR", WeekdayNum{N: -1, Weekday: Friday}.String())
+	assert.Equal(t, "Weekday(9)", WeekdayNum{Weekday: 9}.String())
+}
+
+func ExampleParseRecurrence() {
+	r, _ := ParseRecurrence(New(2026, January, 1), "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3")
+	r.Dates(func(d Date) bool {
+		fmt.Println(d)
+		return true
+	})
+	// Output:
+	// 2026-01-30
+	// 2026-02-27
+	// 2026-03-31
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.lstv.dev/util/constraint"
+)
+
+// relativeMaxInputLength limits ParseRelative input, the longest expression is e.g. "start of quarter".
+const relativeMaxInputLength = 32
+
+// ParseRelative parses relative date expression and resolves it against passed reference date.
+// Expressions are case-insensitive and words can be separated by any white space:
+//   today, yesterday, tomorrow
+//   +3d, -2w, +1m, -1y           offset in days, weeks, months or years
+//   next monday, last fri        the nearest weekday after or before reference date
+//   next week, last month        offset by one week, month or year
+//   start of week, end of month  start or end of week (Monday to Sunday), month, quarter or year
+// Months and years are added with MonthClamp policy, e.g. 2026-01-31 +1m is 2026-02-28.
+// DefaultParser accepts the same expressions resolved against Today if RuleEnableRelative is present,
+// e.g. set DefaultRule to RuleEnableRelative to accept them in Date.UnmarshalText.
+// It returns ParseError if expression is invalid.
+func ParseRelative[T constraint.ParserInput](input T, ref Date) (Date, error) {
+	const funcName = "ParseRelative"
+	b := []byte(input)
+	if l := len(b); l > relativeMaxInputLength {
+		// do not use input for "input too long" error
+		var t T
+		return Date{}, newParseError(funcName, t, fmt.Errorf("%w: %d > %d", ErrInputTooLong, l, relativeMaxInputLength))
+	}
+	d, ok := parseRelative(b, ref)
+	if !ok {
+		return Date{}, newParseError(funcName, input, nil)
+	}
+	return d, nil
+}
+
+// parseRelative resolves relative date expression, see ParseRelative.
+func parseRelative(b []byte, ref Date) (Date, bool) {
+	if len(b) > relativeMaxInputLength {
+		return Date{}, false
+	}
+	words := bytes.Fields(bytes.ToLower(b))
+	switch len(words) {
+	case 1:
+		switch w := string(words[0]); w {
+		case "today":
+			return ref, true
+		case "yesterday":
+			return ref.Add(0, 0, -1), true
+		case "tomorrow":
+			return ref.Add(0, 0, 1), true
+		default:
+			if len(w) < 3 || (w[0] != '+' && w[0] != '-') {
+				return Date{}, false
+			}
+			n, err := strconv.Atoi(w[1 : len(w)-1])
+			if err != nil || w[1] < '0' || w[1] > '9' {
+				return Date{}, false
+			}
+			if w[0] == '-' {
+				n = -n
+			}
+			return addRelative(ref, n, w[len(w)-1])
+		}
+	case 2:
+		n := 0
+		switch string(words[0]) {
+		case "next":
+			n = 1
+		case "last":
+			n = -1
+		default:
+			return Date{}, false
+		}
+		switch w := string(words[1]); w {
+		case "week", "month", "year":
+			return addRelative(ref, n, w[0])
+		default:
+			for wd := Sunday; wd <= Saturday; wd++ {
+				if name := wd.String(); w == strings.ToLower(name) || w == strings.ToLower(name[:3]) {
+					diff := (int(wd) - int(ref.Weekday()) + 7*n) % 7
+					if diff == 0 {
+						diff = 7 * n
+					}
+					return ref.Add(0, 0, diff), true
+				}
+			}
+		}
+	case 3:
+		if string(words[1]) != "of" {
+			return Date{}, false
+		}
+		start := Date{}
+		months := 0
+		switch string(words[2]) {
+		case "week":
+			start = ref.StartOfWeek(Monday)
+			if string(words[0]) == "end" {
+				return start.Add(0, 0, 6), true
+			}
+		case "month":
+			start, months = ref.StartOfMonth(), 1
+		case "quarter":
+			start, months = ref.StartOfQuarter(), 3
+		case "year":
+			start, months = ref.StartOfYear(), 12
+		default:
+			return Date{}, false
+		}
+		switch string(words[0]) {
+		case "start":
+			return start, true
+		case "end":
+			return start.Add(0, months, -1), true
+		}
+	}
+	return Date{}, false
+}
+
+// addRelative adds n units (d, w, m or y) to date.
+func addRelative(d Date, n int, unit byte) (Date, bool) {
+	switch unit {
+	case 'd':
+		return d.Add(0, 0, n), true
+	case 'w':
+		return d.Add(0, 0, 7*n), true
+	case 'm':
+		v, _ := d.AddMonths(n, MonthClamp)
+		return v, true
+	case 'y':
+		v, _ := d.AddYears(n, MonthClamp)
+		return v, true
+	}
+	return Date{}, false
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseRelative(t *testing.T) {
+	ref := New(2026, October, 14) // Wednesday
+	for _, c := range []struct {
+		input    string
+		expected Date
+	}{
+		{`today`, ref},
+		{`Yesterday`, New(2026, October, 13)},
+		{`TOMORROW`, New(2026, October, 15)},
+		{`+3d`, New(2026, October, 17)},
+		{`-2w`, New(2026, September, 30)},
+		{`+0d`, ref},
+		{`+1m`, New(2026, November, 14)},
+		{`-1y`, New(2025, October, 14)},
+		{`next monday`, New(2026, October, 19)},
+		{`next wed`, New(2026, October, 21)},
+		{`last  Wednesday`, New(2026, October, 7)},
+		{`last tue`, New(2026, October, 13)},
+		{`next sunday`, New(2026, October, 18)},
+		{`last sunday`, New(2026, October, 11)},
+		{`next week`, New(2026, October, 21)},
+		{`last month`, New(2026, September, 14)},
+		{`next year`, New(2027, October, 14)},
+		{`start of week`, New(2026, October, 12)},
+		{`end of week`, New(2026, October, 18)},
+		{`start of month`, New(2026, October, 1)},
+		{"end\tof month", New(2026, October, 31)},
+		{`start of quarter`, New(2026, October, 1)},
+		{`end of quarter`, New(2026, December, 31)},
+		{`start of year`, New(2026, January, 1)},
+		{`end of year`, New(2026, December, 31)},
+	} {
+		d, err := ParseRelative(c.input, ref)
+		require.NoError(t, err, c.input)
+		assert.Equal(t, c.expected, d, c.input)
+	}
+	d, err := ParseRelative(`+1m`, New(2026, January, 31))
+	require.NoError(t, err)
+	assert.Equal(t, New(2026, February, 28), d)
+
+	for _, input := range []string{``, `now`, `+d`, `3d`, `+-3d`, `+3x`, `+99999999999999999999d`, `next`, `next moon`, `this monday`, `start of day`, `middle of month`, `start in month`, `a b c d`} {
+		d, err := ParseRelative(input, ref)
+		assert.Zero(t, d, input)
+		assert.Error(t, err, input)
+	}
+	_, err = ParseRelative(`next moon`, ref)
+	assert.EqualError(t, err, `date.ParseRelative: "next moon": invalid date`)
+	var e *ParseError[string]
+	assert.True(t, errors.As(err, &e))
+	_, err = ParseRelative(`start of quarter                 `, ref)
+	assert.EqualError(t, err, `date.ParseRelative: input too long: 33 > 32`)
+}
+
+func Test_DefaultParser_relative(t *testing.T) {
+	defer func() {
+		Now = time.Now
+		DefaultRule = 0
+	}()
+	Now = NewFakeNow(time.Date(2026, October, 14, 12, 0, 0, 0, time.Local)).Now
+	assertDefaultParser(t, New(2026, October, 13), `yesterday`, RuleEnableRelative)
+	assertDefaultParser(t, New(2026, October, 17), `+3d`, RuleEnableRelative)
+	assertDefaultParser(t, New(2026, October, 19), `next monday`, RuleEnableRelative)
+	assertDefaultParser(t, New(2002, August, 7), `2002-08-07`, RuleEnableRelative)
+	assertDefaultParserFail(t, `date.DefaultParser: "yesterday": invalid date`, `yesterday`, 0)
+	assertDefaultParserFail(t, `date.DefaultParser: "+3d": expanded format disabled`, `+3d`, 0)
+
+	d := Date{}
+	assert.Error(t, d.UnmarshalText([]byte(`end of month`)))
+	DefaultRule = RuleEnableRelative
+	require.NoError(t, d.UnmarshalText([]byte(`end of month`)))
+	assert.Equal(t, New(2026, October, 31), d)
+	require.NoError(t, d.Scan(`today`))
+	assert.Equal(t, New(2026, October, 14), d)
+}
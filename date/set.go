@@ -0,0 +1,312 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strconv"
+)
+
+// setYearSize is size of binary representation of one year in Set, i.e. year(4)+bitmap(48).
+const setYearSize = 52
+
+// Set is set of dates stored as bitmap of days for every year, i.e. 48 bytes per year with at least one date.
+// It is intended for large sets of dates (e.g. blackout dates or available days) tested against many dates,
+// Contains does not depend on count of dates in set.
+// Set implements Filter, zero value is empty set ready to use.
+// Set is not safe for concurrent modification.
+type Set struct {
+	years map[int32]*setBitmap
+}
+
+// setBitmap has bit for every day of year, the first bit is January 1.
+type setBitmap [6]uint64
+
+// NewSet creates set of passed dates.
+func NewSet(dates ...Date) *Set {
+	s := &Set{}
+	s.Add(dates...)
+	return s
+}
+
+// Add adds passed dates to set.
+func (s *Set) Add(dates ...Date) {
+	for _, d := range dates {
+		if s.years == nil {
+			s.years = map[int32]*setBitmap{}
+		}
+		b := s.years[d.year]
+		if b == nil {
+			b = &setBitmap{}
+			s.years[d.year] = b
+		}
+		i := d.setIndex()
+		b[i/64] |= 1 << (i % 64)
+	}
+}
+
+// Remove removes passed dates from set.
+func (s *Set) Remove(dates ...Date) {
+	for _, d := range dates {
+		b := s.years[d.year]
+		if b == nil {
+			continue
+		}
+		i := d.setIndex()
+		b[i/64] &^= 1 << (i % 64)
+		if *b == (setBitmap{}) {
+			delete(s.years, d.year)
+		}
+	}
+}
+
+// Contains returns true if passed date is in set.
+func (s *Set) Contains(date Date) bool {
+	b := s.years[date.year]
+	if b == nil {
+		return false
+	}
+	i := date.setIndex()
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+// Len returns count of dates in set.
+func (s *Set) Len() int {
+	n := 0
+	for _, b := range s.years {
+		for _, w := range b {
+			n += bits.OnesCount64(w)
+		}
+	}
+	return n
+}
+
+// Equal returns true if both sets contain the same dates.
+func (s *Set) Equal(o *Set) bool {
+	if len(s.years) != len(o.years) {
+		return false
+	}
+	for y, b := range s.years {
+		if ob := o.years[y]; ob == nil || *ob != *b {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns new set of dates which are in any of sets.
+func (s *Set) Union(o *Set) *Set {
+	u := s.clone()
+	for y, ob := range o.years {
+		if b := u.years[y]; b != nil {
+			for i := range b {
+				b[i] |= ob[i]
+			}
+		} else {
+			u.years[y] = ob.clone()
+		}
+	}
+	return u
+}
+
+// Intersect returns new set of dates which are in both sets.
+func (s *Set) Intersect(o *Set) *Set {
+	r := &Set{years: map[int32]*setBitmap{}}
+	for y, b := range s.years {
+		ob := o.years[y]
+		if ob == nil {
+			continue
+		}
+		v := setBitmap{}
+		for i := range v {
+			v[i] = b[i] & ob[i]
+		}
+		if v != (setBitmap{}) {
+			r.years[y] = &v
+		}
+	}
+	return r
+}
+
+// Difference returns new set of dates which are in set s, but not in set o.
+func (s *Set) Difference(o *Set) *Set {
+	r := &Set{years: map[int32]*setBitmap{}}
+	for y, b := range s.years {
+		v := *b
+		if ob := o.years[y]; ob != nil {
+			for i := range v {
+				v[i] &^= ob[i]
+			}
+		}
+		if v != (setBitmap{}) {
+			r.years[y] = &v
+		}
+	}
+	return r
+}
+
+// Days calls yield for each date in set in ascending order.
+// If yield returns false, iteration stops.
+// Set must not be modified during iteration.
+func (s *Set) Days(yield func(date Date) bool) {
+	for _, y := range s.sortedYears() {
+		start := daysFromCivil(int64(y)+1, 1, 1)
+		for i, w := range s.years[y] {
+			for w != 0 {
+				n := bits.TrailingZeros64(w)
+				w &^= 1 << n
+				if !yield(fromUnixDays(start + int64(i*64+n))) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Dates returns all dates in set in ascending order.
+func (s *Set) Dates() []Date {
+	dates := make([]Date, 0, s.Len())
+	s.Days(func(date Date) bool {
+		dates = append(dates, date)
+		return true
+	})
+	return dates
+}
+
+// Describe returns description of set, e.g. "in set of 3 dates".
+func (s *Set) Describe() string {
+	n := s.Len()
+	if n == 1 {
+		return "in set of 1 date"
+	}
+	return "in set of " + strconv.Itoa(n) + " dates"
+}
+
+// MarshalBinary converts set to binary representation.
+// It never returns error.
+//
+// Byte positions:
+//   0       1-52                  53-104 ...
+//   version year(4) and bitmap(48) of every year in ascending order
+// Year is big-endian 32-bit year, e.g. 2026, the same as in YearMonth and Year binary representation.
+// Bitmap is 6 big-endian 64-bit words, the lowest bit of the first word is January 1.
+func (s *Set) MarshalBinary() ([]byte, error) {
+	years := s.sortedYears()
+	data := make([]byte, 1, 1+len(years)*setYearSize)
+	data[0] = version
+	for _, y := range years {
+		v := y + 1
+		data = append(data, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+		for _, w := range s.years[y] {
+			data = append(data, byte(w>>56), byte(w>>48), byte(w>>40), byte(w>>32), byte(w>>24), byte(w>>16), byte(w>>8), byte(w))
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary sets set from passed data.
+// Years must be in ascending order without duplicates as written by Set.MarshalBinary.
+// It can return wrapped ErrUnsupportedVersion, ErrInvalidLength or ErrOutOfRange.
+func (s *Set) UnmarshalBinary(data []byte) error {
+	l := len(data)
+	if l == 0 {
+		return fmt.Errorf("date.Set.UnmarshalBinary: %w: empty data", ErrInvalidLength)
+	}
+	if data[0] != version {
+		return fmt.Errorf("date.Set.UnmarshalBinary: %w: expected %d instead of %d", ErrUnsupportedVersion, version, data[0])
+	}
+	if (l-1)%setYearSize != 0 {
+		return fmt.Errorf("date.Set.UnmarshalBinary: %w: expected multiple of %d instead of %d", ErrInvalidLength, setYearSize, l-1)
+	}
+	years := make(map[int32]*setBitmap, (l-1)/setYearSize)
+	prev := int32(0)
+	for data = data[1:]; len(data) != 0; data = data[setYearSize:] {
+		y := (int32(data[0])<<24 | int32(data[1])<<16 | int32(data[2])<<8 | int32(data[3])) - 1
+		if len(data) != l-1 && y <= prev {
+			return fmt.Errorf("date.Set.UnmarshalBinary: %w: year %d is not after year %d", ErrOutOfRange, int64(y)+1, int64(prev)+1)
+		}
+		prev = y
+		b := &setBitmap{}
+		for i := range b {
+			for _, v := range data[4+i*8 : 12+i*8] {
+				b[i] = b[i]<<8 | uint64(v)
+			}
+		}
+		// index of December 31 is count of days in year minus one
+		if n := 64*len(b) - bits.LeadingZeros64(b[len(b)-1]); int64(n) > (Date{year: y, month: 11, day: 30}).setIndex()+1 {
+			return fmt.Errorf("date.Set.UnmarshalBinary: %w: day %d of year %d", ErrOutOfRange, n, int64(y)+1)
+		}
+		if *b != (setBitmap{}) {
+			years[y] = b
+		}
+	}
+	s.years = years
+	return nil
+}
+
+// MarshalJSON converts set to JSON array of dates in ascending order.
+// Dates are converted by Date.MarshalJSON.
+func (s *Set) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(s.Dates())
+	if err != nil {
+		return nil, fmt.Errorf("date.Set.MarshalJSON: %w", err)
+	}
+	return b, nil
+}
+
+// UnmarshalJSON sets set from JSON array of dates.
+// Dates are parsed by Date.UnmarshalJSON, duplicates are allowed.
+// JSON null is ignored, but JSON null as array element returns wrapped ErrInvalidType.
+func (s *Set) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	dates := []*Date(nil)
+	if err := json.Unmarshal(data, &dates); err != nil {
+		return fmt.Errorf("date.Set.UnmarshalJSON: %w", err)
+	}
+	for i, d := range dates {
+		if d == nil {
+			return fmt.Errorf("date.Set.UnmarshalJSON: %w: null at index %d", ErrInvalidType, i)
+		}
+	}
+	*s = Set{}
+	for _, d := range dates {
+		s.Add(*d)
+	}
+	return nil
+}
+
+func (s *Set) clone() *Set {
+	c := &Set{years: make(map[int32]*setBitmap, len(s.years))}
+	for y, b := range s.years {
+		c.years[y] = b.clone()
+	}
+	return c
+}
+
+func (s *Set) sortedYears() []int32 {
+	years := make([]int32, 0, len(s.years))
+	for y := range s.years {
+		years = append(years, y)
+	}
+	sort.Slice(years, func(i, j int) bool {
+		return years[i] < years[j]
+	})
+	return years
+}
+
+func (b *setBitmap) clone() *setBitmap {
+	c := *b
+	return &c
+}
+
+// setIndex returns index of date in setBitmap, i.e. day of year (0-365).
+func (d Date) setIndex() int64 {
+	return d.unixDays() - daysFromCivil(int64(d.year)+1, 1, 1)
+}
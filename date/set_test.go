@@ -0,0 +1,154 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Set(t *testing.T) {
+	s := Set{}
+	assert.False(t, s.Contains(New(2026, January, 1)))
+	assert.Zero(t, s.Len())
+	assert.Empty(t, s.Dates())
+
+	dates := []Date{
+		New(2026, December, 31),
+		New(2024, December, 31), // leap year
+		New(2026, January, 1),
+		New(-44, March, 15),
+		New(2026, March, 5),
+		New(2026, January, 1),
+	}
+	s.Add(dates...)
+	for _, d := range dates {
+		assert.True(t, s.Contains(d), d)
+	}
+	assert.False(t, s.Contains(New(2026, January, 2)))
+	assert.False(t, s.Contains(New(2025, January, 1)))
+	assert.Equal(t, 5, s.Len())
+	assert.Equal(t, []Date{New(-44, March, 15), New(2024, December, 31), New(2026, January, 1), New(2026, March, 5), New(2026, December, 31)}, s.Dates())
+	assert.Equal(t, "in set of 5 dates", Describe(&s))
+
+	s.Remove(New(-44, March, 15), New(2026, March, 5), New(2026, March, 6), New(1999, March, 5))
+	assert.Equal(t, []Date{New(2024, December, 31), New(2026, January, 1), New(2026, December, 31)}, s.Dates())
+	assert.Len(t, s.years, 2)
+	assert.True(t, s.Equal(NewSet(New(2024, December, 31), New(2026, January, 1), New(2026, December, 31))))
+	assert.False(t, s.Equal(NewSet(New(2024, December, 31), New(2026, January, 1))))
+	assert.False(t, s.Equal(NewSet(New(2024, December, 31), New(2026, January, 1), New(2027, January, 1))))
+
+	visited := []Date(nil)
+	s.Days(func(date Date) bool {
+		visited = append(visited, date)
+		return len(visited) < 2
+	})
+	assert.Equal(t, []Date{New(2024, December, 31), New(2026, January, 1)}, visited)
+	assert.Equal(t, "in set of 1 date", NewSet(New(2026, January, 1)).Describe())
+
+	f := And(FilterWeekdays(Saturday, Sunday), Not(NewSet(New(2026, October, 17))))
+	assert.False(t, f.Contains(New(2026, October, 17)))
+	assert.True(t, f.Contains(New(2026, October, 18)))
+}
+
+func Test_Set_algebra(t *testing.T) {
+	a := NewSet(New(2025, December, 31), New(2026, January, 1), New(2026, January, 2))
+	b := NewSet(New(2026, January, 2), New(2026, January, 3), New(2027, January, 1))
+	assert.Equal(t, []Date{New(2025, December, 31), New(2026, January, 1), New(2026, January, 2), New(2026, January, 3), New(2027, January, 1)}, a.Union(b).Dates())
+	assert.Equal(t, []Date{New(2026, January, 2)}, a.Intersect(b).Dates())
+	assert.Equal(t, []Date{New(2025, December, 31), New(2026, January, 1)}, a.Difference(b).Dates())
+	assert.Equal(t, []Date{New(2026, January, 3), New(2027, January, 1)}, b.Difference(a).Dates())
+	assert.True(t, a.Intersect(&Set{}).Equal(&Set{}))
+	assert.True(t, a.Difference(a).Equal(&Set{}))
+	assert.True(t, (&Set{}).Union(a).Equal(a))
+
+	// operands are not modified
+	u := a.Union(b)
+	u.Add(New(2025, January, 1))
+	u.Remove(New(2026, January, 1))
+	assert.Equal(t, 3, a.Len())
+	assert.Equal(t, 3, b.Len())
+	assert.True(t, a.Contains(New(2026, January, 1)))
+}
+
+func Test_Set_MarshalBinary(t *testing.T) {
+	data, err := (&Set{}).MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, data)
+
+	data, err = NewSet(New(2026, December, 31), New(2024, January, 1), New(2024, January, 2), New(2024, March, 1)).MarshalBinary()
+	require.NoError(t, err)
+	expected := []byte{1}
+	expected = append(expected, 0, 0, 7, 232)            // 2024
+	expected = append(expected, 16, 0, 0, 0, 0, 0, 0, 3) // March 1 is day 61, i.e. bit 60
+	expected = append(expected, make([]byte, 40)...)
+	expected = append(expected, 0, 0, 7, 234) // 2026
+	expected = append(expected, make([]byte, 40)...)
+	expected = append(expected, 0, 0, 16, 0, 0, 0, 0, 0) // December 31 is day 365, i.e. bit 364
+	assert.Equal(t, expected, data)
+
+	s := Set{}
+	require.NoError(t, s.UnmarshalBinary(data))
+	assert.Equal(t, []Date{New(2024, January, 1), New(2024, January, 2), New(2024, March, 1), New(2026, December, 31)}, s.Dates())
+	require.NoError(t, s.UnmarshalBinary([]byte{1}))
+	assert.Zero(t, s.Len())
+
+	first, last := Date{year: math.MinInt32}, Date{year: math.MaxInt32, month: 11, day: 30}
+	data, err = NewSet(last, first).MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{128, 0, 0, 1}, data[1:5])
+	assert.Equal(t, []byte{128, 0, 0, 0}, data[53:57])
+	require.NoError(t, s.UnmarshalBinary(data))
+	assert.Equal(t, []Date{first, last}, s.Dates())
+}
+
+func Test_Set_UnmarshalBinary_error(t *testing.T) {
+	for _, c := range []struct {
+		data []byte
+		err  string
+		is   error
+	}{
+		{[]byte{}, "date.Set.UnmarshalBinary: invalid length: empty data", ErrInvalidLength},
+		{[]byte{2}, "date.Set.UnmarshalBinary: unsupported version: expected 1 instead of 2", ErrUnsupportedVersion},
+		{[]byte{1, 0, 0, 7, 234}, "date.Set.UnmarshalBinary: invalid length: expected multiple of 52 instead of 4", ErrInvalidLength},
+		{append([]byte{1, 0, 0, 7, 234}, append(make([]byte, 40), 0, 0, 32, 0, 0, 0, 0, 0)...), "date.Set.UnmarshalBinary: out of range: day 366 of year 2026", ErrOutOfRange},
+		{append(append([]byte{1, 0, 0, 7, 234}, make([]byte, 48)...), append([]byte{0, 0, 7, 234}, make([]byte, 48)...)...), "date.Set.UnmarshalBinary: out of range: year 2026 is not after year 2026", ErrOutOfRange},
+		{append(append([]byte{1, 0, 0, 7, 234}, make([]byte, 48)...), append([]byte{0, 0, 7, 233}, make([]byte, 48)...)...), "date.Set.UnmarshalBinary: out of range: year 2025 is not after year 2026", ErrOutOfRange},
+		{append(append([]byte{1, 0, 0, 0, 1}, make([]byte, 48)...), append([]byte{0, 0, 0, 0}, make([]byte, 48)...)...), "date.Set.UnmarshalBinary: out of range: year 0 is not after year 1", ErrOutOfRange},
+	} {
+		s := NewSet(New(2026, January, 1))
+		err := s.UnmarshalBinary(c.data)
+		assert.EqualError(t, err, c.err)
+		assert.True(t, errors.Is(err, c.is))
+		assert.True(t, s.Contains(New(2026, January, 1)))
+	}
+}
+
+func Test_Set_MarshalJSON(t *testing.T) {
+	Formatter = DefaultFormatter
+	data, err := json.Marshal(NewSet(New(2026, October, 18), New(2026, January, 1)))
+	require.NoError(t, err)
+	assert.Equal(t, `["2026-01-01","2026-10-18"]`, string(data))
+	data, err = json.Marshal(&Set{})
+	require.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	s := NewSet(New(2000, January, 1))
+	require.NoError(t, json.Unmarshal([]byte(`["2026-10-18","2026-01-01","2026-10-18"]`), s))
+	assert.Equal(t, []Date{New(2026, January, 1), New(2026, October, 18)}, s.Dates())
+	require.NoError(t, json.Unmarshal([]byte(`null`), s))
+	assert.Equal(t, 2, s.Len())
+	assert.EqualError(t, json.Unmarshal([]byte(`["2026-13-01"]`), s), `date.Set.UnmarshalJSON: date.Date.UnmarshalJSON: date.DefaultParser: "2026-13-01": invalid date`)
+	assert.Error(t, json.Unmarshal([]byte(`{}`), s))
+	err = json.Unmarshal([]byte(`[null,"2026-01-01"]`), s)
+	assert.EqualError(t, err, "date.Set.UnmarshalJSON: invalid type: null at index 0")
+	assert.True(t, errors.Is(err, ErrInvalidType))
+	assert.Equal(t, 2, s.Len())
+}
@@ -0,0 +1,150 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"sort"
+)
+
+// Dates is slice of dates implementing sort.Interface in ascending order.
+type Dates []Date
+
+// Len is implementation for sort.Interface.
+func (s Dates) Len() int {
+	return len(s)
+}
+
+// Less is implementation for sort.Interface.
+func (s Dates) Less(i, j int) bool {
+	return Compare(s[i], s[j]) < 0
+}
+
+// Swap is implementation for sort.Interface.
+func (s Dates) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Compare returns -1 if date a is before date b, 0 if dates are equal and +1 if date a is after date b.
+// It compares year, month and day directly without any conversion.
+func Compare(a, b Date) int {
+	switch {
+	case a.year != b.year:
+		if a.year < b.year {
+			return -1
+		}
+		return 1
+	case a.month != b.month:
+		if a.month < b.month {
+			return -1
+		}
+		return 1
+	case a.day != b.day:
+		if a.day < b.day {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// Sort sorts dates in ascending order.
+func Sort(dates []Date) {
+	sort.Sort(Dates(dates))
+}
+
+// BinarySearch searches for date in slice sorted in ascending order.
+// It returns position where date is found or where it would be inserted and true if date is really present.
+func BinarySearch(dates []Date, date Date) (int, bool) {
+	i := sort.Search(len(dates), func(i int) bool {
+		return Compare(dates[i], date) >= 0
+	})
+	return i, i < len(dates) && dates[i].Equal(date)
+}
+
+// Min returns the earliest of passed dates.
+// If no date is passed, zero date is returned.
+func Min(dates ...Date) Date {
+	if len(dates) == 0 {
+		return Date{}
+	}
+	m := dates[0]
+	for _, d := range dates[1:] {
+		if Compare(d, m) < 0 {
+			m = d
+		}
+	}
+	return m
+}
+
+// Max returns the latest of passed dates.
+// If no date is passed, zero date is returned.
+func Max(dates ...Date) Date {
+	if len(dates) == 0 {
+		return Date{}
+	}
+	m := dates[0]
+	for _, d := range dates[1:] {
+		if Compare(d, m) > 0 {
+			m = d
+		}
+	}
+	return m
+}
+
+// Dedupe removes consecutive duplicate dates in place and returns shortened slice.
+// Sort dates first to remove all duplicates.
+func Dedupe(dates []Date) []Date {
+	if len(dates) < 2 {
+		return dates
+	}
+	n := 1
+	for _, d := range dates[1:] {
+		if !d.Equal(dates[n-1]) {
+			dates[n] = d
+			n++
+		}
+	}
+	return dates[:n]
+}
+
+// GroupByMonth splits dates into groups of the same month.
+// Groups are in order of the first occurrence of month and dates keep their order within group,
+// i.e. for sorted dates groups are sorted too. Month of group is YearMonthOf(group[0]).
+func GroupByMonth(dates []Date) [][]Date {
+	return groupBy(dates, func(d Date) YearMonth {
+		return YearMonthOf(d)
+	})
+}
+
+// GroupByISOWeek splits dates into groups of the same ISO 8601 week.
+// Groups are in order of the first occurrence of week and dates keep their order within group,
+// i.e. for sorted dates groups are sorted too. Week of group is group[0].ISOWeek().
+func GroupByISOWeek(dates []Date) [][]Date {
+	type week struct {
+		year, week int
+	}
+	return groupBy(dates, func(d Date) week {
+		w := week{}
+		w.year, w.week = d.ISOWeek()
+		return w
+	})
+}
+
+// groupBy splits dates into groups of the same key in order of the first occurrence of key.
+func groupBy[K comparable](dates []Date, key func(d Date) K) [][]Date {
+	groups := [][]Date(nil)
+	index := map[K]int{}
+	for _, d := range dates {
+		k := key(d)
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], d)
+	}
+	return groups
+}
@@ -0,0 +1,195 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Compare(t *testing.T) {
+	d := New(2026, October, 18)
+	assert.Equal(t, 0, Compare(d, New(2026, October, 18)))
+	for _, e := range []Date{New(2026, October, 19), New(2026, November, 1), New(2027, January, 1)} {
+		assert.Equal(t, -1, Compare(d, e), e)
+		assert.Equal(t, 1, Compare(e, d), e)
+	}
+	assert.Equal(t, -1, Compare(New(-44, March, 15), Date{}))
+	assert.Equal(t, 1, Compare(Date{}, New(-44, March, 15)))
+}
+
+func Test_Sort(t *testing.T) {
+	dates := []Date{New(2026, October, 18), New(-44, March, 15), New(2026, January, 1), Date{}, New(2026, October, 18)}
+	Sort(dates)
+	assert.Equal(t, []Date{New(-44, March, 15), Date{}, New(2026, January, 1), New(2026, October, 18), New(2026, October, 18)}, dates)
+	Sort(nil)
+
+	dates = []Date{New(2026, October, 18), New(2026, January, 1)}
+	sort.Sort(sort.Reverse(Dates(dates)))
+	assert.Equal(t, []Date{New(2026, October, 18), New(2026, January, 1)}, dates)
+	assert.True(t, sort.IsSorted(sort.Reverse(Dates(dates))))
+}
+
+func Test_BinarySearch(t *testing.T) {
+	dates := []Date{New(2026, January, 1), New(2026, March, 1), New(2026, October, 18)}
+	for _, c := range []struct {
+		date  Date
+		index int
+		found bool
+	}{
+		{New(2025, December, 31), 0, false},
+		{New(2026, January, 1), 0, true},
+		{New(2026, February, 1), 1, false},
+		{New(2026, March, 1), 1, true},
+		{New(2026, October, 18), 2, true},
+		{New(2026, October, 19), 3, false},
+	} {
+		i, ok := BinarySearch(dates, c.date)
+		assert.Equal(t, c.index, i, c.date)
+		assert.Equal(t, c.found, ok, c.date)
+	}
+	i, ok := BinarySearch(nil, Date{})
+	assert.Zero(t, i)
+	assert.False(t, ok)
+}
+
+func Test_Min_Max(t *testing.T) {
+	dates := []Date{New(2026, October, 18), New(-44, March, 15), New(2027, January, 1), New(2026, January, 1)}
+	assert.Equal(t, New(-44, March, 15), Min(dates...))
+	assert.Equal(t, New(2027, January, 1), Max(dates...))
+	assert.Equal(t, New(2026, January, 1), Min(New(2026, October, 18), New(2026, January, 1)))
+	assert.Equal(t, New(2026, October, 18), Max(New(2026, October, 18)))
+	assert.Zero(t, Min())
+	assert.Zero(t, Max())
+}
+
+func Test_Dedupe(t *testing.T) {
+	dates := []Date{New(2026, January, 1), New(2026, January, 1), New(2026, March, 1), New(2026, January, 1), New(2026, January, 1)}
+	assert.Equal(t, []Date{New(2026, January, 1), New(2026, March, 1), New(2026, January, 1)}, Dedupe(dates))
+	assert.Equal(t, New(2026, March, 1), dates[1]) // in place
+	Sort(dates)
+	assert.Equal(t, []Date{New(2026, January, 1), New(2026, March, 1)}, Dedupe(dates))
+	assert.Equal(t, []Date{New(2026, January, 1)}, Dedupe([]Date{New(2026, January, 1)}))
+	assert.Empty(t, Dedupe(nil))
+}
+
+func Test_GroupByMonth(t *testing.T) {
+	groups := GroupByMonth([]Date{
+		New(2026, October, 18),
+		New(2026, January, 31),
+		New(2026, October, 1),
+		New(2025, October, 18),
+		New(2026, January, 1),
+	})
+	assert.Equal(t, [][]Date{
+		{New(2026, October, 18), New(2026, October, 1)},
+		{New(2026, January, 31), New(2026, January, 1)},
+		{New(2025, October, 18)},
+	}, groups)
+	assert.Empty(t, GroupByMonth(nil))
+}
+
+func Test_GroupByISOWeek(t *testing.T) {
+	groups := GroupByISOWeek([]Date{
+		New(2026, December, 27), // 2026-W52-7
+		New(2026, December, 28), // 2026-W53-1
+		New(2027, January, 3),   // 2026-W53-7
+		New(2027, January, 4),   // 2027-W01-1
+		New(2021, December, 28), // 2021-W52-2
+	})
+	assert.Equal(t, [][]Date{
+		{New(2026, December, 27)},
+		{New(2026, December, 28), New(2027, January, 3)},
+		{New(2027, January, 4)},
+		{New(2021, December, 28)},
+	}, groups)
+}
+
+// benchmarkDates returns dates in random order, the same for all benchmarks.
+func benchmarkDates() []Date {
+	r := rand.New(rand.NewSource(1))
+	dates := make([]Date, 10000)
+	for i := range dates {
+		dates[i] = New(2000, January, 1).Add(0, 0, r.Intn(20000))
+	}
+	return dates
+}
+
+func Benchmark_Compare(b *testing.B) {
+	dates := benchmarkDates()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Compare(dates[i%len(dates)], dates[(i+1)%len(dates)])
+	}
+}
+
+func Benchmark_Compare_time(b *testing.B) {
+	dates := benchmarkDates()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dates[i%len(dates)].Time().Before(dates[(i+1)%len(dates)].Time())
+	}
+}
+
+func Benchmark_Sort(b *testing.B) {
+	dates := benchmarkDates()
+	s := make([]Date, len(dates))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(s, dates)
+		Sort(s)
+	}
+}
+
+func Benchmark_Sort_time(b *testing.B) {
+	dates := benchmarkDates()
+	s := make([]Date, len(dates))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(s, dates)
+		sort.Slice(s, func(i, j int) bool {
+			return s[i].Time().Before(s[j].Time())
+		})
+	}
+}
+
+func Benchmark_Sort_times(b *testing.B) {
+	dates := benchmarkDates()
+	s := make([]time.Time, len(dates))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, d := range dates {
+			s[j] = d.Time()
+		}
+		sort.Slice(s, func(i, j int) bool {
+			return s[i].Before(s[j])
+		})
+	}
+}
+
+func Benchmark_BinarySearch(b *testing.B) {
+	dates := benchmarkDates()
+	Sort(dates)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BinarySearch(dates, dates[i%len(dates)])
+	}
+}
+
+func Benchmark_BinarySearch_time(b *testing.B) {
+	dates := benchmarkDates()
+	Sort(dates)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		t := dates[i%len(dates)].Time()
+		sort.Search(len(dates), func(j int) bool {
+			return !dates[j].Time().Before(t)
+		})
+	}
+}
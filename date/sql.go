@@ -0,0 +1,69 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// IntForm allows configuring how Date.Scan converts integer values.
+// Available values are:
+//   IntFormNone
+//   IntFormEpochDays
+//   IntFormYYYYMMDD
+type IntForm int
+
+const (
+	// IntFormNone disallow scanning integer values, Date.Scan returns ErrInvalidType.
+	IntFormNone = IntForm(iota)
+
+	// IntFormEpochDays converts integer as count of days since 1970-01-01, e.g. 20744 is 2026-10-18.
+	IntFormEpochDays
+
+	// IntFormYYYYMMDD converts integer as decimal YYYYMMDD, e.g. 20261018 is 2026-10-18.
+	IntFormYYYYMMDD
+)
+
+// ValueForm allows configuring Date.Value result.
+// Available values are:
+//   ValueFormTime
+//   ValueFormText
+type ValueForm int
+
+const (
+	// ValueFormTime returns time.Time (midnight in time.UTC).
+	ValueFormTime = ValueForm(iota)
+
+	// ValueFormText returns string formatted by Formatter.
+	ValueFormText
+)
+
+var (
+	// ScanIntForm is used by Date.Scan to convert integer values.
+	ScanIntForm = IntFormNone
+
+	// SQLValueForm is used by Date.Value to choose returned type.
+	SQLValueForm = ValueFormTime
+)
+
+// scanInt converts integer value by ScanIntForm.
+func scanInt(v int64) (Date, error) {
+	switch ScanIntForm {
+	case IntFormEpochDays:
+		if v < minUnixDays || v > maxUnixDays {
+			return Date{}, fmt.Errorf("date.Date.Scan: %w: %d days", ErrOutOfRange, v)
+		}
+		return fromUnixDays(v), nil
+	case IntFormYYYYMMDD:
+		year, month, day := int(v/10000), Month(v/100%100), int(v%100)
+		if v <= 0 || v/10000-1 > math.MaxInt32 || month < January || month > December || day == 0 || day > monthDays(year, month) {
+			return Date{}, newParseError("Date.Scan", strconv.FormatInt(v, 10), nil)
+		}
+		return New(year, month, day), nil
+	}
+	return Date{}, fmt.Errorf("date.Date.Scan: %w: integer is disabled by ScanIntForm", ErrInvalidType)
+}
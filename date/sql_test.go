@@ -0,0 +1,89 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Date_Scan_text(t *testing.T) {
+	d := Date{}
+	require.NoError(t, d.Scan("2026-10-18"))
+	assert.Equal(t, New(2026, October, 18), d)
+	require.NoError(t, d.Scan([]byte("2002-08-07")))
+	assert.Equal(t, New(2002, August, 7), d)
+	assert.EqualError(t, d.Scan("2026-10-32"), `date.Date.Scan: date.DefaultParser: "2026-10-32": invalid date`)
+	assert.Equal(t, New(2002, August, 7), d)
+}
+
+func Test_Date_Scan_int(t *testing.T) {
+	defer func() {
+		ScanIntForm = IntFormNone
+	}()
+	d := Date{}
+	err := d.Scan(int64(20261018))
+	assert.True(t, errors.Is(err, ErrInvalidType))
+	assert.EqualError(t, err, "date.Date.Scan: invalid type: integer is disabled by ScanIntForm")
+
+	ScanIntForm = IntFormEpochDays
+	require.NoError(t, d.Scan(int64(20744)))
+	assert.Equal(t, New(2026, October, 18), d)
+	require.NoError(t, d.Scan(0))
+	assert.Equal(t, New(1970, January, 1), d)
+	require.NoError(t, d.Scan(-1))
+	assert.Equal(t, New(1969, December, 31), d)
+	require.NoError(t, d.Scan(maxUnixDays))
+	assert.Equal(t, Date{year: math.MaxInt32, month: 11, day: 30}, d)
+	require.NoError(t, d.Scan(minUnixDays))
+	assert.Equal(t, Date{year: math.MinInt32}, d)
+	for _, v := range []int64{1 << 62, maxUnixDays + 1, minUnixDays - 1} {
+		err := d.Scan(v)
+		assert.True(t, errors.Is(err, ErrOutOfRange), v)
+	}
+	assert.EqualError(t, d.Scan(int64(1<<62)), "date.Date.Scan: out of range: 4611686018427387904 days")
+	assert.Equal(t, Date{year: math.MinInt32}, d)
+
+	ScanIntForm = IntFormYYYYMMDD
+	require.NoError(t, d.Scan(int64(20261018)))
+	assert.Equal(t, New(2026, October, 18), d)
+	require.NoError(t, d.Scan(10101))
+	assert.Equal(t, Date{}, d)
+	for _, v := range []int64{0, -20261018, 20261301, 20261000, 20260229, 2026101} {
+		assert.Error(t, d.Scan(v), v)
+	}
+	assert.EqualError(t, d.Scan(20261032), `date.Date.Scan: "20261032": invalid date`)
+	assert.EqualError(t, d.Scan(int64(21474836490101)), `date.Date.Scan: "21474836490101": invalid date`)
+	assert.Equal(t, Date{}, d)
+}
+
+func Test_Date_Value_text(t *testing.T) {
+	defer func() {
+		SQLValueForm = ValueFormTime
+		Formatter = DefaultFormatter
+	}()
+	Formatter = DefaultFormatter
+	SQLValueForm = ValueFormText
+	v, err := New(2002, August, 7).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2002-08-07", v)
+
+	Formatter = func(buf []byte, d Date, f Format) ([]byte, error) {
+		return nil, errors.New("error")
+	}
+	v, err = New(2002, August, 7).Value()
+	assert.Nil(t, v)
+	assert.EqualError(t, err, "date.Date.Value: error")
+
+	SQLValueForm = ValueFormTime
+	v, err = New(2002, August, 7).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2002, August, 7, 0, 0, 0, 0, time.UTC), v)
+}
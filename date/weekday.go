@@ -0,0 +1,35 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"time"
+)
+
+// Weekday is equivalent to time.Weekday.
+type Weekday = time.Weekday
+
+const (
+	// Sunday is equivalent to time.Sunday.
+	Sunday = time.Sunday
+
+	// Monday is equivalent to time.Monday.
+	Monday = time.Monday
+
+	// Tuesday is equivalent to time.Tuesday.
+	Tuesday = time.Tuesday
+
+	// Wednesday is equivalent to time.Wednesday.
+	Wednesday = time.Wednesday
+
+	// Thursday is equivalent to time.Thursday.
+	Thursday = time.Thursday
+
+	// Friday is equivalent to time.Friday.
+	Friday = time.Friday
+
+	// Saturday is equivalent to time.Saturday.
+	Saturday = time.Saturday
+)
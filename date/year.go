@@ -0,0 +1,248 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+
+	"go.lstv.dev/util/constraint"
+	"go.lstv.dev/util/internal"
+)
+
+var patternYear = regexp.MustCompile(`^(-[0-9]{4,10}|[0-9]{4,9})$`)
+
+// Year is representation of year, i.e. date without month and day.
+// Binary representation requires year to fit into int32.
+// Negative years are formatted with sign and at least 5 digits (e.g. -00044) the same way as DefaultFormatter does.
+type Year int
+
+// YearOf returns year of passed date.
+func YearOf(d Date) Year {
+	return Year(d.Year())
+}
+
+// ParseYear parses year from ISO 8601 format, i.e. YYYY.
+// Negative years with minus sign (e.g. -00044) are accepted, year must fit into int32.
+// It can return *ParseError.
+//
+// See also MaxInputLength.
+func ParseYear[T constraint.ParserInput](input T) (Year, error) {
+	return parseYear("ParseYear", input)
+}
+
+// YearRange creates range from January 1 of from year to December 31 of to year.
+// It can return wrapped ErrInvalidFromOrTo if from year is after to year.
+func YearRange(from, to Year) (Range, error) {
+	if from > to {
+		return Range{}, fmt.Errorf("date.YearRange: %w: %s > %s", ErrInvalidFromOrTo, from, to)
+	}
+	return Range{from: from.FirstDay(), to: to.LastDay(), hasFrom: true, hasTo: true}, nil
+}
+
+// IsLeap returns true if year is leap year.
+func (y Year) IsLeap() bool {
+	return y.Days() == 366
+}
+
+// Days returns count of days in year (365 or 366).
+func (y Year) Days() int {
+	return yearDays(int(y))
+}
+
+// Date returns date with passed month and day in year.
+// Values out of range are normalized the same way as New does.
+func (y Year) Date(month Month, day int) Date {
+	return New(int(y), month, day)
+}
+
+// YearMonth returns passed month in year.
+// Month out of range is normalized the same way as NewYearMonth does.
+func (y Year) YearMonth(month Month) YearMonth {
+	return NewYearMonth(int(y), month)
+}
+
+// FirstDay returns January 1 of year.
+func (y Year) FirstDay() Date {
+	return New(int(y), January, 1)
+}
+
+// LastDay returns December 31 of year.
+func (y Year) LastDay() Date {
+	return New(int(y), December, 31)
+}
+
+// Add passed years to year.
+func (y Year) Add(years int) Year {
+	return y + Year(years)
+}
+
+// After returns true if current year is after passed one.
+func (y Year) After(z Year) bool {
+	return y > z
+}
+
+// Before returns true if current year is before passed one.
+func (y Year) Before(z Year) bool {
+	return y < z
+}
+
+// Range returns range of all days in year.
+func (y Year) Range() Range {
+	return Range{from: y.FirstDay(), to: y.LastDay(), hasFrom: true, hasTo: true}
+}
+
+// Contains returns true if passed date is in year.
+// It allows using Year as Filter.
+func (y Year) Contains(date Date) bool {
+	return date.Year() == int(y)
+}
+
+// Describe returns description of year as filter, e.g. "in 2026".
+func (y Year) Describe() string {
+	return "in " + y.String()
+}
+
+// MarshalBinary converts year to binary representation.
+// It returns wrapped ErrOutOfRange if year does not fit into int32.
+//
+// Byte positions:
+//   0       1-4
+//   version year
+func (y Year) MarshalBinary() ([]byte, error) {
+	v := int32(y)
+	if Year(v) != y {
+		return nil, fmt.Errorf("date.Year.MarshalBinary: %w: %d", ErrOutOfRange, int64(y))
+	}
+	return []byte{
+		version,
+		byte(v >> 24),
+		byte(v >> 16),
+		byte(v >> 8),
+		byte(v),
+	}, nil
+}
+
+// UnmarshalBinary sets year from passed data.
+// It can return wrapped ErrUnsupportedVersion or ErrInvalidLength.
+func (y *Year) UnmarshalBinary(data []byte) error {
+	l := len(data)
+	if l == 0 {
+		return fmt.Errorf("date.Year.UnmarshalBinary: %w: empty data", ErrInvalidLength)
+	}
+	if data[0] != version {
+		return fmt.Errorf("date.Year.UnmarshalBinary: %w: expected %d instead of %d", ErrUnsupportedVersion, version, data[0])
+	}
+	if l != 5 { // version(1)+year(4)
+		return fmt.Errorf("date.Year.UnmarshalBinary: %w: expected 5 instead of %d", ErrInvalidLength, l)
+	}
+	*y = Year(int32(data[1])<<24 | int32(data[2])<<16 | int32(data[3])<<8 | int32(data[4]))
+	return nil
+}
+
+// MarshalText converts year to text, i.e. YYYY.
+// It never returns error.
+func (y Year) MarshalText() ([]byte, error) {
+	return y.appendText(nil), nil
+}
+
+// UnmarshalText parses year using ParseYear.
+func (y *Year) UnmarshalText(data []byte) error {
+	v, err := parseYear("ParseYear", data)
+	if err != nil {
+		return fmt.Errorf("date.Year.UnmarshalText: %w", err)
+	}
+	*y = v
+	return nil
+}
+
+// Format is implementation for fmt.Formatter.
+// Verb %d formats year as number, any other verb formats year as text, i.e. YYYY.
+func (y Year) Format(f fmt.State, verb rune) {
+	if verb == 'd' {
+		f.Write(strconv.AppendInt(nil, int64(y), 10))
+		return
+	}
+	f.Write(y.appendText(nil))
+}
+
+// Scan is support for database/sql package.
+// It accepts time.Time, integer (int64 or int) and text (string or []byte) in YYYY format.
+// It can return wrapped ErrInvalidType, ErrOutOfRange (if year does not fit into int32) or parser error.
+func (y *Year) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		return y.scanInt(int64(v.Year()))
+	case int64:
+		return y.scanInt(v)
+	case int:
+		return y.scanInt(int64(v))
+	case string:
+		return y.scanText([]byte(v))
+	case []byte:
+		return y.scanText(v)
+	}
+	return fmt.Errorf("date.Year.Scan: %w: expected time.Time, integer, string or []byte instead of %T", ErrInvalidType, src)
+}
+
+// Value is support for database/sql package.
+// It returns January 1 as time.Time or text (YYYY) depending on SQLValueForm.
+func (y Year) Value() (driver.Value, error) {
+	if SQLValueForm == ValueFormText {
+		return y.String(), nil
+	}
+	return y.FirstDay().Time(), nil
+}
+
+// String returns year text form, i.e. YYYY.
+func (y Year) String() string {
+	return string(y.appendText(nil))
+}
+
+func (y *Year) scanInt(v int64) error {
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return fmt.Errorf("date.Year.Scan: %w: %d", ErrOutOfRange, v)
+	}
+	*y = Year(v)
+	return nil
+}
+
+func (y *Year) scanText(data []byte) error {
+	v, err := parseYear("ParseYear", data)
+	if err != nil {
+		return fmt.Errorf("date.Year.Scan: %w", err)
+	}
+	*y = v
+	return nil
+}
+
+func (y Year) appendText(buf []byte) []byte {
+	return internal.Bprintf(buf, yearFormat(int64(y), 0), int(y))
+}
+
+func parseYear[T constraint.ParserInput](funcName string, input T) (Year, error) {
+	b := []byte(input)
+	l := len(b)
+	if l == 0 {
+		return 0, newParseError(funcName, b, nil)
+	}
+	if max := signedMaxInputLength(b); max != 0 && l > max {
+		// do not use input for "input too long" error
+		var t T
+		return 0, newParseError(funcName, t, fmt.Errorf("%w: %d > %d", ErrInputTooLong, l, max))
+	}
+	if !patternYear.Match(b) {
+		return 0, newParseError(funcName, input, nil)
+	}
+	year, err := strconv.ParseInt(string(b), 10, 32)
+	if err != nil {
+		return 0, newParseError(funcName, input, nil)
+	}
+	return Year(year), nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.lstv.dev/util/test"
+)
+
+func Test_YearOf(t *testing.T) {
+	assert.Equal(t, Year(2026), YearOf(New(2026, October, 18)))
+}
+
+func Test_Year_days(t *testing.T) {
+	assert.True(t, Year(2024).IsLeap())
+	assert.False(t, Year(2026).IsLeap())
+	assert.False(t, Year(1900).IsLeap())
+	assert.True(t, Year(2000).IsLeap())
+	assert.Equal(t, 365, Year(2026).Days())
+	assert.Equal(t, New(2026, January, 1), Year(2026).FirstDay())
+	assert.Equal(t, New(2026, December, 31), Year(2026).LastDay())
+	assert.Equal(t, New(2026, October, 18), Year(2026).Date(October, 18))
+	assert.Equal(t, NewYearMonth(2026, October), Year(2026).YearMonth(October))
+}
+
+func Test_Year_compare(t *testing.T) {
+	assert.Equal(t, Year(2028), Year(2026).Add(2))
+	assert.True(t, Year(2026).Before(2027))
+	assert.False(t, Year(2026).Before(2026))
+	assert.True(t, Year(2027).After(2026))
+	assert.False(t, Year(2026).After(2026))
+}
+
+func Test_Year_filter(t *testing.T) {
+	y := Year(2026)
+	assert.True(t, y.Contains(New(2026, January, 1)))
+	assert.False(t, y.Contains(New(2027, January, 1)))
+	assert.Equal(t, "in 2026", Describe(y))
+	assert.Equal(t, 365, y.Range().Len())
+
+	r, err := YearRange(2025, 2026)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-01..2026-12-31", r.String())
+	_, err = YearRange(2026, 2025)
+	assert.EqualError(t, err, "date.YearRange: invalid from or to: 2026 > 2025")
+}
+
+func Test_Year_MarshalBinary(t *testing.T) {
+	test.MarshalBinary(t, []test.CaseBinary[Year]{
+		{ // 0
+			Data:  []byte{1, 0, 0, 0, 0},
+			Value: Year(0),
+		},
+		{ // 1
+			Data:  []byte{1, 0, 0, 7, 234},
+			Value: Year(2026),
+		},
+		{ // 2
+			Data:  []byte{1, 255, 255, 255, 212},
+			Value: Year(-44),
+		},
+	})
+	if v := int64(math.MaxInt32) + 1; int64(Year(v)) == v { // only with 64-bit int
+		b, err := Year(v).MarshalBinary()
+		assert.Nil(t, b)
+		assert.EqualError(t, err, "date.Year.MarshalBinary: out of range: 2147483648")
+		assert.True(t, errors.Is(err, ErrOutOfRange))
+	}
+}
+
+func Test_Year_UnmarshalBinary(t *testing.T) {
+	test.UnmarshalBinary(t, []test.CaseBinary[Year]{
+		{ // 0
+			Data:  []byte{1, 0, 0, 7, 234},
+			Value: Year(2026),
+		},
+		{ // 1
+			Error: test.Error("date.Year.UnmarshalBinary: invalid length: empty data"),
+			Data:  []byte{},
+		},
+		{ // 2
+			Error: test.Error("date.Year.UnmarshalBinary: unsupported version: expected 1 instead of 2"),
+			Data:  []byte{2, 0, 0, 7, 234},
+		},
+		{ // 3
+			Error: test.Error("date.Year.UnmarshalBinary: invalid length: expected 5 instead of 6"),
+			Data:  []byte{1, 0, 0, 7, 234, 10},
+		},
+	}, nil)
+}
+
+func Test_Year_MarshalText(t *testing.T) {
+	test.MarshalText(t, []test.CaseText[Year]{
+		{ // 0
+			Data:  `0001`,
+			Value: Year(1),
+		},
+		{ // 1
+			Data:  `2026`,
+			Value: Year(2026),
+		},
+		{ // 2
+			Data:  `0000`,
+			Value: Year(0),
+		},
+		{ // 3
+			Data:  `-00044`,
+			Value: Year(-44),
+		},
+	})
+}
+
+func Test_Year_UnmarshalText(t *testing.T) {
+	test.UnmarshalText(t, []test.CaseText[Year]{
+		{ // 0
+			Data:  `2026`,
+			Value: Year(2026),
+		},
+		{ // 1
+			Data:  `12026`,
+			Value: Year(12026),
+		},
+		{ // 2
+			Error: test.Error(`date.Year.UnmarshalText: date.ParseYear: "26": invalid date`),
+			Data:  `26`,
+		},
+		{ // 3
+			Error: test.Error(`date.Year.UnmarshalText: date.ParseYear: "2026-10": invalid date`),
+			Data:  `2026-10`,
+		},
+		{ // 4
+			Data:  `-00044`,
+			Value: Year(-44),
+		},
+		{ // 5
+			Data:  `-2147483648`,
+			Value: Year(math.MinInt32),
+		},
+		{ // 6
+			Error: test.Error(`date.Year.UnmarshalText: date.ParseYear: "-2147483649": invalid date`),
+			Data:  `-2147483649`,
+		},
+		{ // 7
+			Error: test.Error(`date.Year.UnmarshalText: date.ParseYear: "+2026": invalid date`),
+			Data:  `+2026`,
+		},
+	}, nil)
+}
+
+func Test_Year_Format(t *testing.T) {
+	assert.Equal(t, "0026", fmt.Sprintf("%s", Year(26)))
+	assert.Equal(t, "26", fmt.Sprintf("%d", Year(26)))
+	assert.Equal(t, "2026", fmt.Sprint(Year(2026)))
+}
+
+func Test_Year_Scan(t *testing.T) {
+	y := Year(0)
+	require.NoError(t, y.Scan(time.Date(2026, October, 18, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, Year(2026), y)
+	require.NoError(t, y.Scan(int64(2027)))
+	assert.Equal(t, Year(2027), y)
+	require.NoError(t, y.Scan(2028))
+	assert.Equal(t, Year(2028), y)
+	require.NoError(t, y.Scan("2029"))
+	assert.Equal(t, Year(2029), y)
+	require.NoError(t, y.Scan([]byte("2030")))
+	assert.Equal(t, Year(2030), y)
+	assert.EqualError(t, y.Scan("x"), `date.Year.Scan: date.ParseYear: "x": invalid date`)
+	assert.EqualError(t, y.Scan(1.5), "date.Year.Scan: invalid type: expected time.Time, integer, string or []byte instead of float64")
+	err := y.Scan(int64(math.MaxInt32 + 1))
+	assert.EqualError(t, err, "date.Year.Scan: out of range: 2147483648")
+	assert.True(t, errors.Is(err, ErrOutOfRange))
+	assert.EqualError(t, y.Scan(int64(math.MinInt32-1)), "date.Year.Scan: out of range: -2147483649")
+	require.NoError(t, y.Scan("-00044"))
+	assert.Equal(t, Year(-44), y)
+	require.NoError(t, y.Scan(2030))
+	assert.Equal(t, Year(2030), y)
+}
+
+func Test_Year_Value(t *testing.T) {
+	defer func() {
+		SQLValueForm = ValueFormTime
+	}()
+	v, err := Year(2026).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, January, 1, 0, 0, 0, 0, time.UTC), v)
+	SQLValueForm = ValueFormText
+	v, err = Year(2026).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2026", v)
+}
@@ -0,0 +1,267 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"go.lstv.dev/util/constraint"
+	"go.lstv.dev/util/internal"
+)
+
+var patternYearMonth = regexp.MustCompile(`^(-[0-9]{4,10}|[0-9]{4,9})-(1[0-2]|0[1-9])$`)
+
+// YearMonth is representation of month in specific year, i.e. date without day.
+// Zero value is valid, representing value 0001-01.
+type YearMonth struct {
+	year  int32
+	month uint8
+}
+
+// NewYearMonth creates year month with specific year and month.
+// Month out of range is normalized, e.g. month 13 is January of the next year.
+func NewYearMonth(year int, month Month) YearMonth {
+	return YearMonthOf(New(year, month, 1))
+}
+
+// YearMonthOf returns year month of passed date.
+func YearMonthOf(d Date) YearMonth {
+	return YearMonth{year: d.year, month: d.month}
+}
+
+// ParseYearMonth parses year month from ISO 8601 extended format, i.e. YYYY-MM.
+// It can return *ParseError.
+//
+// See also MaxInputLength.
+func ParseYearMonth[T constraint.ParserInput](input T) (YearMonth, error) {
+	return parseYearMonth("ParseYearMonth", input)
+}
+
+// YearMonthRange creates range from the first day of from month to the last day of to month.
+// It can return wrapped ErrInvalidFromOrTo if from month is after to month.
+func YearMonthRange(from, to YearMonth) (Range, error) {
+	if from.After(to) {
+		return Range{}, fmt.Errorf("date.YearMonthRange: %w: %s > %s", ErrInvalidFromOrTo, from, to)
+	}
+	return Range{from: from.FirstDay(), to: to.LastDay(), hasFrom: true, hasTo: true}, nil
+}
+
+// IsZero returns true if year month is 0001-01.
+func (m YearMonth) IsZero() bool {
+	return m.year == 0 && m.month == 0
+}
+
+// Equal returns true if passed year month is the same value.
+func (m YearMonth) Equal(n YearMonth) bool {
+	return m == n
+}
+
+// Year returns year.
+func (m YearMonth) Year() int {
+	return int(m.year) + 1
+}
+
+// Month returns month (from January to December).
+func (m YearMonth) Month() Month {
+	return Month(m.month + 1)
+}
+
+// Days returns count of days in month (28-31).
+func (m YearMonth) Days() int {
+	return monthDays(m.Year(), m.Month())
+}
+
+// Date returns date with passed day in month.
+// Day out of range is normalized the same way as New does.
+func (m YearMonth) Date(day int) Date {
+	return New(m.Year(), m.Month(), day)
+}
+
+// FirstDay returns the first day of month.
+func (m YearMonth) FirstDay() Date {
+	return Date{year: m.year, month: m.month}
+}
+
+// LastDay returns the last day of month.
+func (m YearMonth) LastDay() Date {
+	return Date{year: m.year, month: m.month, day: uint8(m.Days() - 1)}
+}
+
+// Add passed years and months to year month.
+func (m YearMonth) Add(years int, months int) YearMonth {
+	return NewYearMonth(m.Year()+years, m.Month()+Month(months))
+}
+
+// After returns true if current year month is after passed one.
+// Otherwise, and also if year months are equal, returns false.
+func (m YearMonth) After(n YearMonth) bool {
+	return m.year > n.year || (m.year == n.year && m.month > n.month)
+}
+
+// Before returns true if current year month is before passed one.
+// Otherwise, and also if year months are equal, returns false.
+func (m YearMonth) Before(n YearMonth) bool {
+	return m.year < n.year || (m.year == n.year && m.month < n.month)
+}
+
+// Range returns range of all days in month.
+func (m YearMonth) Range() Range {
+	return Range{from: m.FirstDay(), to: m.LastDay(), hasFrom: true, hasTo: true}
+}
+
+// Contains returns true if passed date is in month.
+// It allows using YearMonth as Filter.
+func (m YearMonth) Contains(date Date) bool {
+	return date.year == m.year && date.month == m.month
+}
+
+// Describe returns description of month as filter, e.g. "in 2026-10".
+func (m YearMonth) Describe() string {
+	return "in " + m.String()
+}
+
+// MarshalBinary converts year month to binary representation.
+// It never returns error.
+//
+// Byte positions:
+//   0       1-4  5
+//   version year month
+func (m YearMonth) MarshalBinary() ([]byte, error) {
+	y := m.year + 1
+	return []byte{
+		version,
+		byte(y >> 24),
+		byte(y >> 16),
+		byte(y >> 8),
+		byte(y),
+		m.month + 1,
+	}, nil
+}
+
+// UnmarshalBinary sets year month from passed data.
+// It can return wrapped ErrUnsupportedVersion or ErrInvalidLength.
+func (m *YearMonth) UnmarshalBinary(data []byte) error {
+	l := len(data)
+	if l == 0 {
+		return fmt.Errorf("date.YearMonth.UnmarshalBinary: %w: empty data", ErrInvalidLength)
+	}
+	if data[0] != version {
+		return fmt.Errorf("date.YearMonth.UnmarshalBinary: %w: expected %d instead of %d", ErrUnsupportedVersion, version, data[0])
+	}
+	if l != 6 { // version(1)+year(4)+month(1)
+		return fmt.Errorf("date.YearMonth.UnmarshalBinary: %w: expected 6 instead of %d", ErrInvalidLength, l)
+	}
+	m.year = (int32(data[1])<<24 | int32(data[2])<<16 | int32(data[3])<<8 | int32(data[4])) - 1
+	m.month = data[5] - 1
+	return nil
+}
+
+// MarshalText converts year month to text, i.e. YYYY-MM.
+// It never returns error.
+func (m YearMonth) MarshalText() ([]byte, error) {
+	return m.appendText(nil), nil
+}
+
+// UnmarshalText parses year month using ParseYearMonth.
+func (m *YearMonth) UnmarshalText(data []byte) error {
+	v, err := parseYearMonth("ParseYearMonth", data)
+	if err != nil {
+		return fmt.Errorf("date.YearMonth.UnmarshalText: %w", err)
+	}
+	*m = v
+	return nil
+}
+
+// Format is implementation for fmt.Formatter.
+//
+//   ┌ Verb ┬ Example ───────┐
+//   │ %s   │ "2006-01"      │
+//   │ %L   │ "January 2006" │
+//
+// Verb %L uses month name in nominative case from DefaultLocale.
+// Other verbs are the same as %s.
+func (m YearMonth) Format(f fmt.State, verb rune) {
+	if verb == 'L' {
+		b, _ := formatLayout(nil, m.FirstDay(), "%OB %Y", DefaultLocale)
+		f.Write(b)
+		return
+	}
+	f.Write(m.appendText(nil))
+}
+
+// Scan is support for database/sql package.
+// It accepts time.Time and text (string or []byte) in YYYY-MM format
+// or any format accepted by global Parser function with DefaultRule.
+// It can return wrapped ErrInvalidType or parser error.
+func (m *YearMonth) Scan(src any) error {
+	b := []byte(nil)
+	switch v := src.(type) {
+	case time.Time:
+		*m = YearMonthOf(FromTime(v))
+		return nil
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return fmt.Errorf("date.YearMonth.Scan: %w: expected time.Time, string or []byte instead of %T", ErrInvalidType, src)
+	}
+	if v, err := parseYearMonth("ParseYearMonth", b); err == nil {
+		*m = v
+		return nil
+	}
+	d, err := Parser(b, DefaultRule)
+	if err != nil {
+		return fmt.Errorf("date.YearMonth.Scan: %w", err)
+	}
+	*m = YearMonthOf(d)
+	return nil
+}
+
+// Value is support for database/sql package.
+// It returns the first day of month as time.Time or text (YYYY-MM) depending on SQLValueForm.
+func (m YearMonth) Value() (driver.Value, error) {
+	if SQLValueForm == ValueFormText {
+		return m.String(), nil
+	}
+	return m.FirstDay().Time(), nil
+}
+
+// String returns year month text form, i.e. YYYY-MM.
+func (m YearMonth) String() string {
+	return string(m.appendText(nil))
+}
+
+func (m YearMonth) appendText(buf []byte) []byte {
+	year := int64(m.year) + 1
+	return internal.Bprintf(buf, yearFormat(year, 0)+`-%02d`, year, m.month+1)
+}
+
+func parseYearMonth[T constraint.ParserInput](funcName string, input T) (YearMonth, error) {
+	b := []byte(input)
+	l := len(b)
+	if l == 0 {
+		return YearMonth{}, newParseError(funcName, b, nil)
+	}
+	if max := signedMaxInputLength(b); max != 0 && l > max {
+		// do not use input for "input too long" error
+		var t T
+		return YearMonth{}, newParseError(funcName, t, fmt.Errorf("%w: %d > %d", ErrInputTooLong, l, max))
+	}
+	parts := patternYearMonth.FindSubmatch(b)
+	if len(parts) == 0 {
+		return YearMonth{}, newParseError(funcName, input, nil)
+	}
+	year, ok := parseYearNumber(parts[1])
+	if !ok {
+		return YearMonth{}, newParseError(funcName, input, nil)
+	}
+	month, _ := strconv.Atoi(string(parts[2]))
+	return NewYearMonth(year, Month(month)), nil
+}
@@ -0,0 +1,240 @@
+// Copyright 2022 Livesport TV s.r.o. All rights reserved.
+// Use of this source code is governed by a MIT license
+// that can be found in the LICENSE file.
+
+package date
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.lstv.dev/util/test"
+)
+
+func Test_NewYearMonth(t *testing.T) {
+	m := NewYearMonth(2026, October)
+	assert.Equal(t, 2026, m.Year())
+	assert.Equal(t, October, m.Month())
+	assert.Equal(t, NewYearMonth(2027, January), NewYearMonth(2026, 13))
+	assert.Equal(t, NewYearMonth(2025, December), NewYearMonth(2026, 0))
+	assert.Equal(t, YearMonth{}, NewYearMonth(1, January))
+	assert.True(t, YearMonth{}.IsZero())
+	assert.False(t, m.IsZero())
+}
+
+func Test_YearMonthOf(t *testing.T) {
+	assert.Equal(t, NewYearMonth(2026, October), YearMonthOf(New(2026, October, 18)))
+}
+
+func Test_YearMonth_days(t *testing.T) {
+	m := NewYearMonth(2024, February)
+	assert.Equal(t, 29, m.Days())
+	assert.Equal(t, 28, NewYearMonth(2026, February).Days())
+	assert.Equal(t, New(2024, February, 1), m.FirstDay())
+	assert.Equal(t, New(2024, February, 29), m.LastDay())
+	assert.Equal(t, New(2024, February, 10), m.Date(10))
+	assert.Equal(t, New(2024, March, 1), m.Date(30))
+}
+
+func Test_YearMonth_Add(t *testing.T) {
+	m := NewYearMonth(2026, October)
+	assert.Equal(t, NewYearMonth(2027, February), m.Add(0, 4))
+	assert.Equal(t, NewYearMonth(2025, December), m.Add(0, -10))
+	assert.Equal(t, NewYearMonth(2028, October), m.Add(2, 0))
+}
+
+func Test_YearMonth_compare(t *testing.T) {
+	m, n := NewYearMonth(2026, October), NewYearMonth(2026, November)
+	assert.True(t, m.Before(n))
+	assert.False(t, n.Before(m))
+	assert.False(t, m.Before(m))
+	assert.True(t, n.After(m))
+	assert.False(t, m.After(n))
+	assert.False(t, m.After(m))
+	assert.True(t, NewYearMonth(2025, December).Before(m))
+	assert.True(t, m.Equal(NewYearMonth(2026, October)))
+	assert.False(t, m.Equal(n))
+}
+
+func Test_YearMonth_filter(t *testing.T) {
+	m := NewYearMonth(2026, October)
+	assert.True(t, m.Contains(New(2026, October, 1)))
+	assert.True(t, m.Contains(New(2026, October, 31)))
+	assert.False(t, m.Contains(New(2026, November, 1)))
+	assert.False(t, m.Contains(New(2025, October, 1)))
+	assert.Equal(t, "in 2026-10", Describe(m))
+	assert.Equal(t, 31, m.Range().Len())
+	r, ok := RangeFromFilter(m)
+	assert.True(t, ok)
+	assert.Equal(t, m.Range(), r)
+
+	r, err := YearMonthRange(NewYearMonth(2026, November), NewYearMonth(2027, February))
+	require.NoError(t, err)
+	assert.Equal(t, "2026-11-01..2027-02-28", r.String())
+	assert.True(t, r.Contains(New(2027, January, 15)))
+	assert.False(t, r.Contains(New(2026, October, 31)))
+
+	_, err = YearMonthRange(NewYearMonth(2026, November), NewYearMonth(2026, October))
+	assert.EqualError(t, err, "date.YearMonthRange: invalid from or to: 2026-11 > 2026-10")
+}
+
+func Test_YearMonth_MarshalBinary(t *testing.T) {
+	test.MarshalBinary(t, []test.CaseBinary[YearMonth]{
+		{ // 0
+			Data:  []byte{1, 0, 0, 0, 1, 1},
+			Value: YearMonth{},
+		},
+		{ // 1
+			Data:  []byte{1, 0, 0, 7, 234, 10},
+			Value: NewYearMonth(2026, October),
+		},
+	})
+}
+
+func Test_YearMonth_UnmarshalBinary(t *testing.T) {
+	test.UnmarshalBinary(t, []test.CaseBinary[YearMonth]{
+		{ // 0
+			Data:  []byte{1, 0, 0, 7, 234, 10},
+			Value: NewYearMonth(2026, October),
+		},
+		{ // 1
+			Error: test.Error("date.YearMonth.UnmarshalBinary: invalid length: empty data"),
+			Data:  []byte{},
+		},
+		{ // 2
+			Error: test.Error("date.YearMonth.UnmarshalBinary: unsupported version: expected 1 instead of 2"),
+			Data:  []byte{2, 0, 0, 7, 234, 10},
+		},
+		{ // 3
+			Error: test.Error("date.YearMonth.UnmarshalBinary: invalid length: expected 6 instead of 7"),
+			Data:  []byte{1, 0, 0, 7, 234, 10, 18},
+		},
+	}, nil)
+}
+
+func Test_YearMonth_MarshalText(t *testing.T) {
+	test.MarshalText(t, []test.CaseText[YearMonth]{
+		{ // 0
+			Data:  `0001-01`,
+			Value: YearMonth{},
+		},
+		{ // 1
+			Data:  `2026-10`,
+			Value: NewYearMonth(2026, October),
+		},
+		{ // 2
+			Data:  `-00044-03`,
+			Value: NewYearMonth(-44, March),
+		},
+	})
+}
+
+func Test_YearMonth_UnmarshalText(t *testing.T) {
+	test.UnmarshalText(t, []test.CaseText[YearMonth]{
+		{ // 0
+			Data:  `2026-10`,
+			Value: NewYearMonth(2026, October),
+		},
+		{ // 1
+			Data:  `12026-01`,
+			Value: NewYearMonth(12026, January),
+		},
+		{ // 2
+			Error: test.Error(`date.YearMonth.UnmarshalText: date.ParseYearMonth: "2026-13": invalid date`),
+			Data:  `2026-13`,
+		},
+		{ // 3
+			Error: test.Error(`date.YearMonth.UnmarshalText: date.ParseYearMonth: "202610": invalid date`),
+			Data:  `202610`,
+		},
+		{ // 4
+			Error: test.Error(`date.YearMonth.UnmarshalText: date.ParseYearMonth: invalid date`),
+			Data:  ``,
+		},
+		{ // 5
+			Error: test.Error(`date.YearMonth.UnmarshalText: date.ParseYearMonth: input too long: 11 > 10`),
+			Data:  `2026-10-181`,
+		},
+		{ // 6
+			Data:  `-00044-03`,
+			Value: NewYearMonth(-44, March),
+		},
+		{ // 7
+			Data:  `-0000-12`,
+			Value: NewYearMonth(0, December),
+		},
+		{ // 8
+			Error: test.Error(`date.YearMonth.UnmarshalText: date.ParseYearMonth: "-2147483648-01": invalid date`),
+			Data:  `-2147483648-01`,
+		},
+		{ // 9
+			Error: test.Error(`date.YearMonth.UnmarshalText: date.ParseYearMonth: "+2026-10": invalid date`),
+			Data:  `+2026-10`,
+		},
+	}, nil)
+}
+
+func Test_YearMonth_json(t *testing.T) {
+	v := struct {
+		M YearMonth `json:"m"`
+	}{}
+	require.NoError(t, json.Unmarshal([]byte(`{"m":"2026-10"}`), &v))
+	assert.Equal(t, NewYearMonth(2026, October), v.M)
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.Equal(t, `{"m":"2026-10"}`, string(b))
+}
+
+func Test_YearMonth_Format(t *testing.T) {
+	defer func() {
+		DefaultLocale = English
+	}()
+	m := NewYearMonth(2026, October)
+	assert.Equal(t, "2026-10", fmt.Sprintf("%s", m))
+	assert.Equal(t, "2026-10", fmt.Sprint(m))
+	assert.Equal(t, "October 2026", fmt.Sprintf("%L", m))
+	DefaultLocale = Czech
+	assert.Equal(t, "říjen 2026", fmt.Sprintf("%L", m))
+}
+
+func Test_YearMonth_Scan(t *testing.T) {
+	m := YearMonth{}
+	require.NoError(t, m.Scan(time.Date(2026, October, 18, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, NewYearMonth(2026, October), m)
+	require.NoError(t, m.Scan("2026-11"))
+	assert.Equal(t, NewYearMonth(2026, November), m)
+	require.NoError(t, m.Scan([]byte("2026-12-01")))
+	assert.Equal(t, NewYearMonth(2026, December), m)
+	assert.EqualError(t, m.Scan("x"), `date.YearMonth.Scan: date.DefaultParser: "x": invalid date`)
+	assert.EqualError(t, m.Scan(1), "date.YearMonth.Scan: invalid type: expected time.Time, string or []byte instead of int")
+	assert.Equal(t, NewYearMonth(2026, December), m)
+
+	defer func() {
+		Now = time.Now
+		DefaultRule = 0
+	}()
+	Now = NewFakeNow(time.Date(2026, October, 14, 12, 0, 0, 0, time.Local)).Now
+	DefaultRule = RuleDisableBasic
+	assert.EqualError(t, m.Scan("20261001"), `date.YearMonth.Scan: date.DefaultParser: "20261001": basic format disabled`)
+	DefaultRule = RuleEnableRelative
+	require.NoError(t, m.Scan("today"))
+	assert.Equal(t, NewYearMonth(2026, October), m)
+}
+
+func Test_YearMonth_Value(t *testing.T) {
+	defer func() {
+		SQLValueForm = ValueFormTime
+	}()
+	v, err := NewYearMonth(2026, October).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, October, 1, 0, 0, 0, 0, time.UTC), v)
+	SQLValueForm = ValueFormText
+	v, err = NewYearMonth(2026, October).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-10", v)
+}